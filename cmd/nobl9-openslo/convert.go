@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/nobl9/nobl9-go/sdk"
+
+	"github.com/nobl9/nobl9-openslo/pkg/openslotonobl9"
+)
+
+// stdinPath is the path which instructs the command to read from stdin.
+const stdinPath = "-"
+
+const convertUsage = `Usage: nobl9-openslo convert [flags] [path...]
+
+Convert OpenSLO objects to Nobl9 objects.
+Each path is either a file, a directory which is searched recursively for
+'.yaml', '.yml' and '.json' files, or a glob pattern, like 'slos/**/*.yaml'.
+If no path is provided, or the path is '-', objects are read from stdin.
+
+Flags:
+`
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("convert", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), convertUsage)
+		flags.PrintDefaults()
+	}
+	project := flags.String("project", "",
+		"Nobl9 project assigned to objects which don't define one (default \"default\")")
+	generateProjects := flags.Bool("generate-projects", false,
+		"generate Nobl9 Project objects for every project referenced by the converted objects")
+	output := flags.String("output", "", "write the Nobl9 objects to the file instead of stdout")
+	formatName := flags.String("format", "yaml", "output format, one of: yaml, json")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return newUsageError(err)
+	}
+	format, err := manifest.ParseObjectFormat(*formatName)
+	if err != nil {
+		return newUsageError(fmt.Errorf("invalid --format: %w", err))
+	}
+
+	objects, sources, err := readObjects(flags.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	options := []openslotonobl9.Option{
+		openslotonobl9.WithLogger(slog.New(slog.NewTextHandler(stderr, nil))),
+	}
+	if *project != "" {
+		options = append(options, openslotonobl9.WithDefaultProject(*project))
+	}
+	if *generateProjects {
+		options = append(options, openslotonobl9.WithProjectGeneration(nil))
+	}
+	nobl9Objects, err := openslotonobl9.ConvertWithOptions(objects, options...)
+	if err != nil {
+		return newConversionError(sources.Locate(err))
+	}
+
+	var buf bytes.Buffer
+	if err = sdk.EncodeObjects(nobl9Objects, &buf, format); err != nil {
+		return newConversionError(fmt.Errorf("failed to encode Nobl9 objects: %w", err))
+	}
+	if *output == "" {
+		if _, err = buf.WriteTo(stdout); err != nil {
+			return newIOError(fmt.Errorf("failed to write Nobl9 objects: %w", err))
+		}
+		return nil
+	}
+	if err = os.WriteFile(*output, buf.Bytes(), 0o600); err != nil {
+		return newIOError(fmt.Errorf("failed to write Nobl9 objects: %w", err))
+	}
+	return nil
+}
+
+// readObjects decodes OpenSLO objects from all the provided paths.
+// Objects read from files come first, their sources are used to locate conversion errors.
+func readObjects(paths []string, stdin io.Reader) ([]openslo.Object, openslotonobl9.ObjectSources, error) {
+	if len(paths) == 0 {
+		paths = []string{stdinPath}
+	}
+	var (
+		objects   []openslo.Object
+		sources   openslotonobl9.ObjectSources
+		patterns  []string
+		readStdin bool
+	)
+	for _, path := range paths {
+		if path == stdinPath {
+			readStdin = true
+		} else {
+			patterns = append(patterns, path)
+		}
+	}
+	if len(patterns) > 0 {
+		loaded, loadedSources, err := openslotonobl9.LoadPathsWithSources(patterns...)
+		if err != nil {
+			var pathErr *fs.PathError
+			if errors.Is(err, fs.ErrNotExist) || errors.As(err, &pathErr) {
+				return nil, sources, newIOError(err)
+			}
+			return nil, sources, newConversionError(err)
+		}
+		objects, sources = loaded, loadedSources
+	}
+	if readStdin {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, sources, newIOError(fmt.Errorf("failed to read from stdin: %w", err))
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			return objects, sources, nil
+		}
+		decoded, err := openslosdk.Decode(bytes.NewReader(data), openslosdk.FormatYAML)
+		if err != nil {
+			return nil, sources, newConversionError(fmt.Errorf("failed to decode OpenSLO objects from stdin: %w", err))
+		}
+		objects = append(objects, decoded...)
+	}
+	return objects, sources, nil
+}
@@ -0,0 +1,72 @@
+// Package main implements nobl9-openslo CLI which converts OpenSLO objects to Nobl9 objects.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	exitCodeOK = 0
+	// exitCodeConversion is returned when the input is not valid or could not be converted.
+	exitCodeConversion = 1
+	// exitCodeUsage is returned when the command was invoked incorrectly.
+	exitCodeUsage = 2
+	// exitCodeIO is returned when the input could not be read or the output could not be written.
+	exitCodeIO = 3
+)
+
+const usage = `Usage: nobl9-openslo <command> [flags]
+
+Commands:
+  convert  Convert OpenSLO objects to Nobl9 objects.
+
+Run 'nobl9-openslo <command> -h' for the command's flags.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitCodeUsage
+	}
+	var err error
+	switch args[0] {
+	case "convert":
+		err = runConvert(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return exitCodeOK
+	default:
+		fmt.Fprintf(stderr, "unknown command: %s\n\n%s", args[0], usage)
+		return exitCodeUsage
+	}
+	if err == nil {
+		return exitCodeOK
+	}
+	fmt.Fprintf(stderr, "Error: %v\n", err)
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return exitCodeConversion
+}
+
+// exitError carries the exit code which the CLI should terminate with.
+type exitError struct {
+	code int
+	err  error
+}
+
+func newUsageError(err error) error      { return &exitError{code: exitCodeUsage, err: err} }
+func newIOError(err error) error         { return &exitError{code: exitCodeIO, err: err} }
+func newConversionError(err error) error { return &exitError{code: exitCodeConversion, err: err} }
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
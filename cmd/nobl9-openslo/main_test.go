@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serviceYAML = `apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: web
+spec:
+  description: Web service
+`
+
+const expectedServiceYAML = `- apiVersion: n9/v1alpha
+  kind: Service
+  metadata:
+    name: web
+    project: my-project
+    annotations:
+      openslo.com/apiVersion: openslo/v1
+      openslo.com/implicit-project: "true"
+  spec:
+    description: Web service
+`
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "service.yaml"), []byte(serviceYAML), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# SLOs"), 0o600))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "invalid.yml.bak"),
+		[]byte(strings.ReplaceAll(serviceYAML, "Service", "Unknown")),
+		0o600,
+	))
+	invalidDir := t.TempDir()
+	invalidFile := filepath.Join(invalidDir, "service.yaml")
+	require.NoError(t, os.WriteFile(
+		invalidFile,
+		[]byte(strings.ReplaceAll(serviceYAML, "name: web", "name: Web Service")),
+		0o600,
+	))
+
+	tests := map[string]struct {
+		args           []string
+		stdin          string
+		exitCode       int
+		stdout         string
+		stdoutContains string
+		stderrContains string
+	}{
+		"convert from stdin": {
+			args:     []string{"convert", "--project", "my-project"},
+			stdin:    serviceYAML,
+			exitCode: exitCodeOK,
+			stdout:   expectedServiceYAML,
+		},
+		"convert from directory": {
+			args:     []string{"convert", "--project=my-project", dir},
+			exitCode: exitCodeOK,
+			stdout:   expectedServiceYAML,
+		},
+		"convert to JSON": {
+			args:           []string{"convert", "--project", "my-project", "--format", "json", "-"},
+			stdin:          serviceYAML,
+			exitCode:       exitCodeOK,
+			stdoutContains: `"project": "my-project"`,
+		},
+		"generate projects": {
+			args:           []string{"convert", "--project", "my-project", "--generate-projects"},
+			stdin:          serviceYAML,
+			exitCode:       exitCodeOK,
+			stdoutContains: "kind: Project\n  metadata:\n    name: my-project",
+		},
+		"invalid object": {
+			args:           []string{"convert"},
+			stdin:          strings.ReplaceAll(serviceYAML, "name: web", "name: Web Service"),
+			exitCode:       exitCodeConversion,
+			stderrContains: "failed to validate OpenSLO objects",
+		},
+		"invalid object in file": {
+			args:           []string{"convert", invalidDir},
+			exitCode:       exitCodeConversion,
+			stderrContains: invalidFile + ":4:3: Validation for v1.Service 'Web Service'",
+		},
+		"missing file": {
+			args:           []string{"convert", filepath.Join(dir, "missing.yaml")},
+			exitCode:       exitCodeIO,
+			stderrContains: "no files match pattern",
+		},
+		"invalid format": {
+			args:           []string{"convert", "--format", "toml"},
+			exitCode:       exitCodeUsage,
+			stderrContains: "invalid --format",
+		},
+		"unknown command": {
+			args:           []string{"foo"},
+			exitCode:       exitCodeUsage,
+			stderrContains: "unknown command: foo",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			exitCode := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+			assert.Equal(t, tc.exitCode, exitCode, stderr.String())
+			if tc.stdout != "" {
+				assert.Equal(t, tc.stdout, stdout.String())
+			}
+			assert.Contains(t, stdout.String(), tc.stdoutContains)
+			assert.Contains(t, stderr.String(), tc.stderrContains)
+		})
+	}
+
+	t.Run("write to output file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "nobl9.yaml")
+		var stdout, stderr bytes.Buffer
+		exitCode := run(
+			[]string{"convert", "--project", "my-project", "--output", output},
+			strings.NewReader(serviceYAML),
+			&stdout,
+			&stderr,
+		)
+		require.Equal(t, exitCodeOK, exitCode, stderr.String())
+		assert.Empty(t, stdout.String())
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.Equal(t, expectedServiceYAML, string(data))
+	})
+}
@@ -6,9 +6,15 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
 
+// ImplicitProjectKey is the Nobl9 annotation which marks objects whose project was not defined
+// with 'nobl9.com/metadata.project' OpenSLO annotation, but resolved by the OpenSLO to Nobl9 converter.
+// The Nobl9 to OpenSLO converter does not add the project annotation to such objects.
+const ImplicitProjectKey = "openslo.com/implicit-project"
+
 // AddOpenSLOToNobl9 adds OpenSLO annotations to the given Nobl9 JSON object.
 // Annotations are added to metadata.annotations.<key>, where key is of the following format:
 //
@@ -19,29 +25,89 @@ import (
 //	AddOpenSLOToNobl9(jsonObject, "path.to.annotation", "value") ->
 //	`{"metadata":{"annotations":{"openslo.com/path.to.annotation":"value"}}}`
 //
-// Complex objects are marshaled to JSON before being added to the object.
+// Nobl9 annotations can only hold strings, every other value is marshaled to JSON.
 func AddOpenSLOToNobl9(jsonObject, path string, value any) (string, error) {
-	rt := reflect.TypeOf(value)
-	if rt.Kind() == reflect.Pointer {
-		rt = rt.Elem()
-	}
-	var (
-		data any
-		err  error
-	)
-	switch rt.Kind() {
-	case reflect.Slice, reflect.Map, reflect.Struct, reflect.Array:
-		data, err = json.Marshal(value)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal value for path %s: %w", path, err)
-		}
-	default:
-		data = value
+	data, err := annotationValue(path, value)
+	if err != nil {
+		return "", err
 	}
 	annotationKey := escapeDots("openslo.com/" + path)
 	return sjson.Set(jsonObject, "metadata.annotations."+annotationKey, data)
 }
 
+// AddNobl9ToOpenSLO adds Nobl9 annotations to the given OpenSLO JSON object.
+// It is the inverse of [AddOpenSLOToNobl9].
+// Annotations are added to metadata.annotations.<key>, where key is of the following format:
+//
+//	nobl9.com/<path>
+//
+// OpenSLO annotations can only hold strings, every other value is marshaled to JSON.
+func AddNobl9ToOpenSLO(jsonObject, path string, value any) (string, error) {
+	data, err := annotationValue(path, value)
+	if err != nil {
+		return "", err
+	}
+	annotationKey := escapeDots("nobl9.com/" + path)
+	return sjson.Set(jsonObject, "metadata.annotations."+annotationKey, data)
+}
+
+// AddImplicitProject adds [ImplicitProjectKey] annotation to the given Nobl9 JSON object.
+func AddImplicitProject(jsonObject string) (string, error) {
+	return sjson.Set(jsonObject, "metadata.annotations."+escapeDots(ImplicitProjectKey), "true")
+}
+
+func annotationValue(path string, value any) (string, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal value for path %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
 func escapeDots(path string) string {
 	return strings.ReplaceAll(path, ".", "\\.")
 }
+
+// GetOpenSLOFromNobl9 returns the OpenSLO annotations added with [AddOpenSLOToNobl9].
+// The returned map is keyed by the OpenSLO path, the "openslo.com/" prefix is removed.
+// [ImplicitProjectKey] is not an OpenSLO path and is not returned.
+func GetOpenSLOFromNobl9(annotations map[string]string) map[string]string {
+	result := make(map[string]string)
+	for key, value := range annotations {
+		if key == ImplicitProjectKey {
+			continue
+		}
+		if path, ok := strings.CutPrefix(key, "openslo.com/"); ok {
+			result[path] = value
+		}
+	}
+	return result
+}
+
+// SetOpenSLOValue sets the annotation value at the given path of the OpenSLO JSON object.
+// It is the inverse of [AddOpenSLOToNobl9].
+//
+// Values which are valid JSON numbers, booleans, arrays or objects are set as raw JSON,
+// any other value is set as a string.
+func SetOpenSLOValue(jsonObject, path, value string) (string, error) {
+	if isRawJSONValue(value) {
+		return sjson.SetRaw(jsonObject, path, value)
+	}
+	return sjson.Set(jsonObject, path, value)
+}
+
+func isRawJSONValue(value string) bool {
+	if !json.Valid([]byte(value)) {
+		return false
+	}
+	switch gjson.Parse(value).Type {
+	case gjson.Number, gjson.True, gjson.False, gjson.JSON:
+		return true
+	default:
+		return false
+	}
+}
@@ -35,7 +35,7 @@ func TestAddOpenSLOAnnotations(t *testing.T) {
 			jsonObject: `{}`,
 			path:       "another.annotation",
 			value:      123,
-			expected:   `{"metadata":{"annotations":{"openslo.com/another.annotation":123}}}`,
+			expected:   `{"metadata":{"annotations":{"openslo.com/another.annotation":"123"}}}`,
 		},
 		{
 			name:       "struct value",
@@ -69,6 +69,63 @@ func TestAddOpenSLOAnnotations(t *testing.T) {
 	}
 }
 
+func TestAddNobl9ToOpenSLO(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "string value",
+			path:     "spec.releaseChannel",
+			value:    "beta",
+			expected: `{"metadata":{"annotations":{"nobl9.com/spec.releaseChannel":"beta"}}}`,
+		},
+		{
+			name:     "numeric value",
+			path:     "spec.value",
+			value:    1.5,
+			expected: `{"metadata":{"annotations":{"nobl9.com/spec.value":"1.5"}}}`,
+		},
+		{
+			name:     "boolean value",
+			path:     "spec.enabled",
+			value:    true,
+			expected: `{"metadata":{"annotations":{"nobl9.com/spec.enabled":"true"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := AddNobl9ToOpenSLO(`{}`, tt.path, tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestSetOpenSLOValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "string value", value: "foo", expected: `{"spec":{"foo":"foo"}}`},
+		{name: "numeric value", value: "123", expected: `{"spec":{"foo":123}}`},
+		{name: "object value", value: `{"bar":1}`, expected: `{"spec":{"foo":{"bar":1}}}`},
+		{name: "quoted string value", value: `"bar"`, expected: `{"spec":{"foo":"\"bar\""}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SetOpenSLOValue(`{}`, "spec.foo", tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestThis(t *testing.T) {
 	object := `{"A":{"B":[{"name":[{"C":"D"}]}, {"name":[{"C":"D"}]}]}}`
 	result := gjson.Get(object, "A.B.#.name.#.C")
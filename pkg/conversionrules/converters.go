@@ -1,3 +1,8 @@
+// Package conversionrules defines the rules which convert the values found at the paths
+// of a source object to a JSON representation of the target object.
+//
+// Paths are dot-separated, array indexes are path segments and '#' matches any segment,
+// for example 'spec.objectives.#.target'.
 package conversionrules
 
 import (
@@ -9,12 +14,17 @@ import (
 	"github.com/nobl9/nobl9-openslo/internal/jsonpath"
 )
 
+// ConversionFunc converts the value found at the source object path
+// and returns the updated target JSON object.
 type ConversionFunc func(jsonObject, path string, v any) (updatedJSON string, err error)
 
+// Converter converts the value found at the source object path
+// and returns the updated target JSON object.
 type Converter interface {
 	Convert(jsonObject, path string, v any) (updatedJSON string, err error)
 }
 
+// Noop ignores the value, it can be used to mark paths which are converted by other rules.
 func Noop() Converter {
 	return noopConverter{}
 }
@@ -25,6 +35,7 @@ func (c noopConverter) Convert(jsonObject, _ string, _ any) (updatedJSON string,
 	return jsonObject, nil
 }
 
+// Direct sets the value at the same path of the target object.
 func Direct() Converter {
 	return directConverter{}
 }
@@ -35,6 +46,7 @@ func (c directConverter) Convert(jsonObject, path string, v any) (updatedJSON st
 	return jsonpath.Set(jsonObject, path, v)
 }
 
+// Path sets the value at the provided path of the target object.
 func Path(path string) Converter {
 	return pathConverter{path: path}
 }
@@ -47,6 +59,8 @@ func (c pathConverter) Convert(jsonObject, _ string, v any) (updatedJSON string,
 	return jsonpath.Set(jsonObject, c.path, v)
 }
 
+// PathIndex sets the value at the target object path formatted with the array indexes of the source path,
+// for example 'spec.alertMethods.%d.metadata.name' for 'spec.notificationTargets.0.targetRef'.
 func PathIndex(format string) Converter {
 	return pathIndexConverter{format: format}
 }
@@ -70,6 +84,7 @@ func (c pathIndexConverter) Convert(jsonObject, path string, v any) (updatedJSON
 	return jsonpath.Set(jsonObject, newPath, v)
 }
 
+// Value sets the value returned by the function at the same path of the target object.
 func Value(f func(v any) (any, error)) Converter {
 	return valueConverter{f: f}
 }
@@ -86,6 +101,7 @@ func (c valueConverter) Convert(jsonObject, path string, v any) (updatedJSON str
 	return jsonpath.Set(jsonObject, path, convertedValue)
 }
 
+// Custom converts the value with the provided function.
 func Custom(f ConversionFunc) Converter {
 	return customConverter{f: f}
 }
@@ -98,6 +114,7 @@ func (c customConverter) Convert(jsonObject, path string, v any) (updatedJSON st
 	return c.f(jsonObject, path, v)
 }
 
+// Annotation preserves the value as an 'openslo.com/<path>' annotation of the Nobl9 object.
 func Annotation() Converter {
 	return annotationConverter{}
 }
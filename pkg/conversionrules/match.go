@@ -0,0 +1,70 @@
+package conversionrules
+
+import (
+	"cmp"
+	"strings"
+)
+
+const pathSeparator = "."
+
+// matchPath returns true if the generic path representation matches concrete path.
+// The generic path can contain special identifier for wildcard array index: '#'.
+func matchPath(generic, concrete string) bool {
+	if generic == concrete {
+		return true
+	}
+	ys := strings.Split(generic, pathSeparator)
+	cs := strings.Split(concrete, pathSeparator)
+
+	if len(ys) != len(cs) {
+		return false
+	}
+	for i := range ys {
+		if ys[i] == cs[i] {
+			continue
+		}
+		if ys[i] == "#" {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// compareSpecificity compares generic paths matching the same concrete path.
+// It returns a positive number if the first path is more specific than the second one.
+// The path with fewer wildcards is more specific,
+// if both have the same number of wildcards, the one whose first wildcard comes later is.
+//
+// Example, in the order of decreasing specificity:
+//
+//	spec.objectives.#.target
+//	spec.#.0.target
+//	spec.#.#.target
+func compareSpecificity(generic1, generic2 string) int {
+	ys1 := strings.Split(generic1, pathSeparator)
+	ys2 := strings.Split(generic2, pathSeparator)
+	if c := cmp.Compare(countWildcards(ys2), countWildcards(ys1)); c != 0 {
+		return c
+	}
+	for i := range min(len(ys1), len(ys2)) {
+		w1, w2 := ys1[i] == "#", ys2[i] == "#"
+		switch {
+		case w1 && !w2:
+			return -1
+		case !w1 && w2:
+			return 1
+		}
+	}
+	return 0
+}
+
+func countWildcards(segments []string) int {
+	n := 0
+	for _, segment := range segments {
+		if segment == "#" {
+			n++
+		}
+	}
+	return n
+}
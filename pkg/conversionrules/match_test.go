@@ -55,3 +55,22 @@ func TestMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareSpecificity(t *testing.T) {
+	tests := []struct {
+		generic1 string
+		generic2 string
+		expected int
+	}{
+		{generic1: "a.#.c", generic2: "a.#.c", expected: 0},
+		{generic1: "a.b.#", generic2: "a.#.#", expected: 1},
+		{generic1: "a.#.#", generic2: "a.b.#", expected: -1},
+		{generic1: "a.b.#", generic2: "a.#.c", expected: 1},
+		{generic1: "#.b.c", generic2: "a.#.c", expected: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.generic1+"~"+test.generic2, func(t *testing.T) {
+			assert.Equal(t, test.expected, compareSpecificity(test.generic1, test.generic2))
+		})
+	}
+}
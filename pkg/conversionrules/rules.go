@@ -0,0 +1,56 @@
+package conversionrules
+
+import "strings"
+
+// Rules maps source object paths to the [Converter] which converts their values.
+type Rules map[string]Converter
+
+// Convert converts the value with the [Converter] matching the path, see [Rules.Match].
+// If no rule matches the path, the JSON object is returned unchanged.
+func (r Rules) Convert(jsonObject, path string, v any) (string, error) {
+	if rule, ok := r.Match(path); ok {
+		return rule.Convert(jsonObject, path, v)
+	}
+	return jsonObject, nil
+}
+
+// Match returns the [Converter] registered for the path.
+// Exact matches take precedence over generic paths with wildcard array indexes.
+// If multiple generic paths match, the most specific one is used, which is the path with the fewest wildcards,
+// or, for the same number of wildcards, the one whose first wildcard comes later.
+func (r Rules) Match(path string) (Converter, bool) {
+	if rule, ok := r[path]; ok {
+		return rule, true
+	}
+	var (
+		matched string
+		found   bool
+	)
+	for rulePath := range r {
+		if !matchPath(rulePath, path) {
+			continue
+		}
+		if !found || compareSpecificity(rulePath, matched) > 0 {
+			matched, found = rulePath, true
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return r[matched], true
+}
+
+// Covers returns true if the path or any of its parents has a matching rule.
+// Paths which are not covered would be silently dropped by [Rules.Convert].
+func (r Rules) Covers(path string) bool {
+	for {
+		if _, ok := r.Match(path); ok {
+			return true
+		}
+		i := strings.LastIndex(path, pathSeparator)
+		if i == -1 {
+			return false
+		}
+		path = path[:i]
+	}
+}
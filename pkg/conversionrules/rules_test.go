@@ -0,0 +1,62 @@
+package conversionrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules_Covers(t *testing.T) {
+	rules := Rules{
+		"metadata.name":        Direct(),
+		"spec.objectives.#":    Noop(),
+		"spec.indicator.#.foo": Noop(),
+	}
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "metadata.name", expected: true},
+		{path: "metadata.name.foo", expected: true},
+		{path: "metadata", expected: false},
+		{path: "metadata.project", expected: false},
+		{path: "spec.objectives.1.value", expected: true},
+		{path: "spec.objectives", expected: false},
+		{path: "spec.indicator.0.foo.bar", expected: true},
+		{path: "spec.indicator.0.bar", expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, rules.Covers(test.path))
+		})
+	}
+}
+
+func TestRules_Match(t *testing.T) {
+	rules := Rules{
+		"spec.objectives.0.target": Path("exact"),
+		"spec.objectives.#.target": Path("objectives"),
+		"spec.#.0.target":          Path("first"),
+		"spec.#.#.target":          Path("any"),
+	}
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "spec.objectives.0.target", expected: "exact"},
+		{path: "spec.objectives.1.target", expected: "objectives"},
+		{path: "spec.conditions.0.target", expected: "first"},
+		{path: "spec.conditions.1.target", expected: "any"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			// Rules are stored in a map, repeat the match to ensure the order of iteration does not matter.
+			for range 100 {
+				updated, err := rules.Convert("{}", test.path, 1)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"`+test.expected+`":1}`, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,503 @@
+package nobl9toopenslo
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/agent"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertmethod"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertpolicy"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/direct"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/twindow"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/nobl9/nobl9-openslo/internal/annotations"
+	"github.com/nobl9/nobl9-openslo/pkg/conversionrules"
+)
+
+func getConversionRules(version manifest.Version, kind manifest.Kind) (conversionrules.Rules, error) {
+	switch version {
+	case manifest.VersionV1alpha:
+		switch kind {
+		case manifest.KindService:
+			return v1alphaCommonRules, nil
+		case manifest.KindSLO:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaSLORules), nil
+		case manifest.KindAgent:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaDataSourceRules, v1alphaAgentRules), nil
+		case manifest.KindDirect:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaDataSourceRules, v1alphaDirectRules), nil
+		case manifest.KindAlertPolicy:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaAlertPolicyRules), nil
+		case manifest.KindAlertMethod:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaAlertMethodRules), nil
+		default:
+			return nil, fmt.Errorf("unsupported kind %s for version %s", kind, version)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported API version %s", version)
+	}
+}
+
+var v1alphaCommonRules = conversionrules.Rules{
+	"apiVersion": conversionrules.Value(func(any) (any, error) {
+		return openslo.VersionV1.String(), nil
+	}),
+	"kind":                 conversionrules.Direct(),
+	"metadata.name":        conversionrules.Direct(),
+	"metadata.displayName": conversionrules.Direct(),
+	"metadata.labels":      conversionrules.Direct(),
+	"metadata.project":     conversionrules.Custom(convertProject),
+	"metadata.annotations": conversionrules.Custom(convertAnnotations),
+	"spec.description":     conversionrules.Direct(),
+	// Fields computed by Nobl9 have no OpenSLO equivalent.
+	"status":       conversionrules.Noop(),
+	"organization": conversionrules.Noop(),
+	"manifestSrc":  conversionrules.Noop(),
+}
+
+// nolint: lll
+var v1alphaSLORules = conversionrules.Rules{
+	"spec.service":                               conversionrules.Direct(),
+	"spec.budgetingMethod":                       conversionrules.Direct(),
+	"spec.alertPolicies.#":                       conversionrules.PathIndex("spec.alertPolicies.%d.alertPolicyRef"),
+	"spec.indicator.metricSource.name":           conversionrules.Custom(convertSLOMetricSourceName),
+	"spec.indicator.metricSource.project":        conversionrules.Custom(convertSLOMetricSourceProject),
+	"spec.indicator.metricSource.kind":           conversionrules.Custom(convertSLOMetricSourceKind),
+	"spec.objectives.#.displayName":              conversionrules.Direct(),
+	"spec.objectives.#.name":                     conversionrules.Custom(convertObjectiveName),
+	"spec.objectives.#.target":                   conversionrules.Direct(),
+	"spec.objectives.#.timeSliceTarget":          conversionrules.Direct(),
+	"spec.objectives.#.op":                       conversionrules.Direct(),
+	"spec.objectives.#.value":                    conversionrules.Direct(),
+	"spec.objectives.#.countMetrics.incremental": conversionrules.Custom(convertSLOIndicatorValue(opensloRatioMetricPath + ".counter")),
+	"spec.objectives.#.countMetrics.good":        conversionrules.Custom(convertSLOMetricSpec(opensloRatioMetricPath + ".good")),
+	"spec.objectives.#.countMetrics.bad":         conversionrules.Custom(convertSLOMetricSpec(opensloRatioMetricPath + ".bad")),
+	"spec.objectives.#.countMetrics.total":       conversionrules.Custom(convertSLOMetricSpec(opensloRatioMetricPath + ".total")),
+	"spec.objectives.#.rawMetric.query":          conversionrules.Custom(convertSLOMetricSpec(opensloThresholdMetricPath)),
+	"spec.timeWindows.#":                         conversionrules.Custom(convertSLOTimeWindow),
+	"spec.createdAt":                             conversionrules.Noop(),
+	"spec.createdBy":                             conversionrules.Noop(),
+	"spec.tier":                                  conversionrules.Noop(),
+}
+
+var v1alphaDataSourceRules = conversionrules.Rules{
+	"kind":          conversionrules.Custom(convertDataSourceKind),
+	"oktaClientID":  conversionrules.Noop(),
+	"spec.interval": conversionrules.Noop(),
+	"spec.timeout":  conversionrules.Noop(),
+	"spec.jitter":   conversionrules.Noop(),
+}
+
+var (
+	v1alphaAgentRules  = getDataSourceTypeRules(reflect.TypeOf(agent.Spec{}))
+	v1alphaDirectRules = getDataSourceTypeRules(reflect.TypeOf(direct.Spec{}))
+)
+
+var v1alphaAlertPolicyRules = conversionrules.Rules{
+	"spec.severity":                     conversionrules.Path("spec.conditions.#.spec.severity"),
+	"spec.conditions.#":                 conversionrules.Custom(convertAlertCondition),
+	"spec.alertMethods.#.metadata.name": conversionrules.PathIndex("spec.notificationTargets.%d.targetRef"),
+}
+
+var v1alphaAlertMethodRules = mergeConversionRules(
+	conversionrules.Rules{
+		"kind": conversionrules.Value(func(any) (any, error) {
+			return openslo.KindAlertNotificationTarget.String(), nil
+		}),
+	},
+	getAlertMethodTypeRules(),
+)
+
+const (
+	opensloThresholdMetricPath = "spec.indicator.spec.thresholdMetric"
+	opensloRatioMetricPath     = "spec.indicator.spec.ratioMetric"
+	// conditionMeasurementAnnotation is the AlertCondition annotation which selects
+	// the Nobl9 measurement the OpenSLO 'burnrate' condition is converted to.
+	conditionMeasurementAnnotation = "nobl9.com/measurement"
+)
+
+func convertProject(jsonObject, path string, v any) (updatedJSON string, err error) {
+	project, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	if project == "" || project == defaultProject {
+		return jsonObject, nil
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, project)
+}
+
+func convertAnnotations(jsonObject, path string, v any) (updatedJSON string, err error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected map[string]any, got %T", path, v)
+	}
+	for key, av := range m {
+		// OpenSLO annotations are restored once the whole object is converted.
+		if strings.HasPrefix(key, "openslo.com/") {
+			continue
+		}
+		// Escape dots in the key to avoid interpreting them as a path.
+		key = strings.ReplaceAll(key, ".", "\\.")
+		jsonObject, err = sjson.Set(jsonObject, path+"."+key, av)
+		if err != nil {
+			return "", err
+		}
+	}
+	return jsonObject, nil
+}
+
+// convertSLOIndicatorValue sets the value in the OpenSLO SLO indicator.
+// Nobl9 defines metrics per objective, whereas OpenSLO SLO has a single indicator,
+// which is why all the objectives must define the same metrics.
+func convertSLOIndicatorValue(opensloPath string) conversionrules.ConversionFunc {
+	return func(jsonObject, path string, v any) (updatedJSON string, err error) {
+		if current := gjson.Get(jsonObject, opensloPath); current.Exists() {
+			if !reflect.DeepEqual(current.Value(), v) {
+				return "", fmt.Errorf(
+					"%s differs between objectives, OpenSLO SLO can only define a single indicator for all objectives",
+					path)
+			}
+			return jsonObject, nil
+		}
+		return sjson.Set(jsonObject, opensloPath, v)
+	}
+}
+
+func convertSLOMetricSpec(opensloPath string) conversionrules.ConversionFunc {
+	return func(jsonObject, path string, v any) (updatedJSON string, err error) {
+		metricSpec, ok := v.(map[string]any)
+		if !ok || len(metricSpec) != 1 {
+			return "", fmt.Errorf("invalid value for %s, expected exactly one metric source type", path)
+		}
+		metricSource := make(map[string]any, 2)
+		for typ, spec := range metricSpec {
+			metricSource["type"] = typ
+			metricSource["spec"] = spec
+		}
+		return convertSLOIndicatorValue(opensloPath+".metricSource")(jsonObject, path, metricSource)
+	}
+}
+
+func convertSLOMetricSourceName(jsonObject, _ string, v any) (updatedJSON string, err error) {
+	for _, path := range []string{
+		opensloThresholdMetricPath,
+		opensloRatioMetricPath + ".good",
+		opensloRatioMetricPath + ".bad",
+		opensloRatioMetricPath + ".total",
+	} {
+		if !gjson.Get(jsonObject, path).Exists() {
+			continue
+		}
+		jsonObject, err = sjson.Set(jsonObject, path+".metricSource.metricSourceRef", v)
+		if err != nil {
+			return "", err
+		}
+	}
+	return jsonObject, nil
+}
+
+func convertSLOMetricSourceProject(jsonObject, path string, v any) (updatedJSON string, err error) {
+	project, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	sloProject := gjson.Get(jsonObject, `metadata.annotations.nobl9\.com/metadata\.project`).String()
+	if sloProject == "" {
+		sloProject = defaultProject
+	}
+	if project == "" || project == sloProject {
+		return jsonObject, nil
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, project)
+}
+
+func convertSLOMetricSourceKind(jsonObject, path string, v any) (updatedJSON string, err error) {
+	kind, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	if kind == "" || kind == manifest.KindAgent.String() {
+		return jsonObject, nil
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, kind)
+}
+
+func convertObjectiveName(jsonObject, path string, v any) (updatedJSON string, err error) {
+	name, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	if name == "" {
+		return jsonObject, nil
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, name)
+}
+
+func convertSLOTimeWindow(jsonObject, path string, v any) (updatedJSON string, err error) {
+	timeWindow, err := anyToType[slo.TimeWindow](v)
+	if err != nil {
+		return "", err
+	}
+	duration, err := timeWindowToDurationShorthand(timeWindow.Unit, timeWindow.Count)
+	if err != nil {
+		return "", err
+	}
+	opensloTimeWindow := v1.SLOTimeWindow{
+		Duration:  duration,
+		IsRolling: timeWindow.IsRolling,
+	}
+	if timeWindow.Calendar != nil {
+		opensloTimeWindow.Calendar = &v1.SLOCalendar{
+			StartTime: timeWindow.Calendar.StartTime,
+			TimeZone:  timeWindow.Calendar.TimeZone,
+		}
+	}
+	return sjson.Set(jsonObject, strings.Replace(path, "spec.timeWindows", "spec.timeWindow", 1), opensloTimeWindow)
+}
+
+func timeWindowToDurationShorthand(unit string, count int) (v1.DurationShorthand, error) {
+	if !twindow.IsTimeUnit(unit) {
+		return v1.DurationShorthand{}, fmt.Errorf("unsupported time window unit %s", unit)
+	}
+	var durationUnit v1.DurationShorthandUnit
+	switch twindow.MustParseTimeUnit(unit) {
+	case twindow.Minute:
+		durationUnit = v1.DurationShorthandUnitMinute
+	case twindow.Hour:
+		durationUnit = v1.DurationShorthandUnitHour
+	case twindow.Day:
+		durationUnit = v1.DurationShorthandUnitDay
+	case twindow.Week:
+		durationUnit = v1.DurationShorthandUnitWeek
+	case twindow.Month:
+		durationUnit = v1.DurationShorthandUnitMonth
+	case twindow.Quarter:
+		durationUnit = v1.DurationShorthandUnitQuarter
+	case twindow.Year:
+		durationUnit = v1.DurationShorthandUnitYear
+	default:
+		return v1.DurationShorthand{}, fmt.Errorf("time window unit %s cannot be expressed as OpenSLO duration", unit)
+	}
+	return v1.NewDurationShorthand(count, durationUnit), nil
+}
+
+func convertDataSourceKind(jsonObject, path string, v any) (updatedJSON string, err error) {
+	kind, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	jsonObject, err = sjson.Set(jsonObject, path, openslo.KindDataSource.String())
+	if err != nil {
+		return "", err
+	}
+	if kind != manifest.KindDirect.String() {
+		return jsonObject, nil
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, kind)
+}
+
+func convertDataSourceConnectionDetails(jsonObject, path string, v any) (updatedJSON string, err error) {
+	typ := path[strings.LastIndex(path, ".")+1:]
+	jsonObject, err = sjson.Set(jsonObject, "spec.type", typ)
+	if err != nil {
+		return "", err
+	}
+	return sjson.Set(jsonObject, "spec.connectionDetails", v)
+}
+
+// convertAlertCondition converts Nobl9 alert condition to OpenSLO 'burnrate' condition.
+// Measurements other than 'averageBurnRate' are recorded as 'nobl9.com/measurement' condition annotation.
+// Time to burn measurements define the value as a duration, which becomes the threshold in hours.
+// Nobl9 does not support 'alertingWindow' for 'burnedBudget', its lookbackWindow is restored
+// from the annotation preserved by the OpenSLO to Nobl9 converter.
+func convertAlertCondition(jsonObject, path string, v any) (updatedJSON string, err error) {
+	condition, err := anyToType[alertpolicy.AlertCondition](v)
+	if err != nil {
+		return "", err
+	}
+	measurement, err := alertpolicy.ParseMeasurement(condition.Measurement)
+	if err != nil {
+		return "", fmt.Errorf("unsupported measurement '%s' for %s", condition.Measurement, path)
+	}
+	threshold, err := getAlertConditionThreshold(measurement, condition.Value, path)
+	if err != nil {
+		return "", err
+	}
+	conditionType := v1.AlertConditionType{
+		Kind:      v1.AlertConditionKindBurnRate,
+		Operator:  v1.Operator(condition.Operator),
+		Threshold: &threshold,
+	}
+	if conditionType.Operator == "" {
+		defaultOperator, err := alertpolicy.GetDefaultOperatorForMeasurement(measurement)
+		if err != nil {
+			return "", err
+		}
+		conditionType.Operator = v1.Operator(defaultOperator.String())
+	}
+	switch {
+	case measurement == alertpolicy.MeasurementBurnedBudget:
+	case condition.AlertingWindow == "":
+		return "", fmt.Errorf("%s.alertingWindow is required to define OpenSLO lookbackWindow", path)
+	default:
+		conditionType.LookbackWindow, err = durationToDurationShorthand(condition.AlertingWindow)
+		if err != nil {
+			return "", err
+		}
+	}
+	if condition.LastsForDuration != "" {
+		alertAfter, err := durationToDurationShorthand(condition.LastsForDuration)
+		if err != nil {
+			return "", err
+		}
+		conditionType.AlertAfter = &alertAfter
+	}
+
+	index, err := strconv.Atoi(path[strings.LastIndex(path, ".")+1:])
+	if err != nil {
+		return "", fmt.Errorf("path %q is missing index", path)
+	}
+	if index > 0 {
+		return "", fmt.Errorf("%s is not supported, OpenSLO AlertPolicy can only define a single condition", path)
+	}
+	name := fmt.Sprintf("%s-condition-%d", gjson.Get(jsonObject, "metadata.name").String(), index+1)
+	metadata := v1.Metadata{Name: name}
+	if measurement != alertpolicy.MeasurementAverageBurnRate {
+		metadata.Annotations = v1.Annotations{conditionMeasurementAnnotation: measurement.String()}
+	}
+	conditionPath := fmt.Sprintf("spec.conditions.%d", index)
+	jsonObject, err = sjson.Set(jsonObject, conditionPath, v1.AlertPolicyConditionInline{
+		Kind:     openslo.KindAlertCondition,
+		Metadata: metadata,
+		Spec:     v1.AlertConditionSpec{Condition: conditionType},
+	})
+	if err != nil || measurement != alertpolicy.MeasurementBurnedBudget {
+		return jsonObject, err
+	}
+	return sjson.Delete(jsonObject, conditionPath+".spec.condition.lookbackWindow")
+}
+
+// getAlertConditionThreshold returns the OpenSLO threshold of the Nobl9 condition value.
+// Time to burn measurements define the value as a duration, the threshold is its number of hours.
+func getAlertConditionThreshold(measurement alertpolicy.Measurement, value any, path string) (float64, error) {
+	switch measurement {
+	case alertpolicy.MeasurementTimeToBurnBudget, alertpolicy.MeasurementTimeToBurnEntireBudget:
+		s, ok := value.(string)
+		if !ok {
+			return 0, fmt.Errorf("invalid type for %s.value, expected string, got %T", path, value)
+		}
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse duration %s: %w", s, err)
+		}
+		return duration.Hours(), nil
+	default:
+		threshold, ok := value.(float64)
+		if !ok {
+			return 0, fmt.Errorf("invalid type for %s.value, expected number, got %T", path, value)
+		}
+		return threshold, nil
+	}
+}
+
+// durationToDurationShorthand converts Nobl9 duration string, like '1h30m',
+// to the largest OpenSLO duration shorthand unit which can represent it without loss.
+func durationToDurationShorthand(s string) (v1.DurationShorthand, error) {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return v1.DurationShorthand{}, fmt.Errorf("failed to parse duration %s: %w", s, err)
+	}
+	const (
+		day  = 24 * time.Hour
+		week = 7 * day
+	)
+	switch {
+	case duration%time.Minute != 0:
+		return v1.DurationShorthand{}, fmt.Errorf("duration %s cannot be expressed in whole minutes", s)
+	case duration >= week && duration%week == 0:
+		return v1.NewDurationShorthand(int(duration/week), v1.DurationShorthandUnitWeek), nil
+	case duration >= day && duration%day == 0:
+		return v1.NewDurationShorthand(int(duration/day), v1.DurationShorthandUnitDay), nil
+	case duration >= time.Hour && duration%time.Hour == 0:
+		return v1.NewDurationShorthand(int(duration/time.Hour), v1.DurationShorthandUnitHour), nil
+	default:
+		return v1.NewDurationShorthand(int(duration/time.Minute), v1.DurationShorthandUnitMinute), nil
+	}
+}
+
+// convertAlertMethod sets the alert method type as OpenSLO notification target.
+// The alert method spec is preserved as a single JSON Nobl9 annotation.
+func convertAlertMethod(jsonObject, path string, v any) (updatedJSON string, err error) {
+	target := path[strings.LastIndex(path, ".")+1:]
+	jsonObject, err = sjson.Set(jsonObject, "spec.target", target)
+	if err != nil {
+		return "", err
+	}
+	return annotations.AddNobl9ToOpenSLO(jsonObject, path, v)
+}
+
+func getDataSourceTypeRules(specType reflect.Type) conversionrules.Rules {
+	rules := make(conversionrules.Rules)
+	for _, name := range getFieldNamesWithTypeSuffix(specType, "Config") {
+		rules["spec."+name] = conversionrules.Custom(convertDataSourceConnectionDetails)
+	}
+	return rules
+}
+
+func getAlertMethodTypeRules() conversionrules.Rules {
+	rules := make(conversionrules.Rules)
+	for _, name := range getFieldNamesWithTypeSuffix(reflect.TypeOf(alertmethod.Spec{}), "Method") {
+		rules["spec."+name] = conversionrules.Custom(convertAlertMethod)
+	}
+	return rules
+}
+
+func getFieldNamesWithTypeSuffix(rt reflect.Type, suffix string) []string {
+	names := make([]string, 0, rt.NumField())
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !strings.HasSuffix(field.Type.String(), suffix) {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		split := strings.Split(tag, ",")
+		if len(split) == 0 {
+			continue
+		}
+		names = append(names, split[0])
+	}
+	return names
+}
+
+func mergeConversionRules(rules ...conversionrules.Rules) conversionrules.Rules {
+	merged := make(conversionrules.Rules)
+	for _, r := range rules {
+		maps.Copy(merged, r)
+	}
+	return merged
+}
+
+// anyToType converts any value to a specific type.
+// It uses JSON conversion as an intermediary step.
+func anyToType[T any](v any) (result T, err error) {
+	rawJSON, err := json.Marshal(v)
+	if err != nil {
+		return result, fmt.Errorf("failed to convert %T to %T: %w", v, result, err)
+	}
+	if err = json.Unmarshal(rawJSON, &result); err != nil {
+		return result, fmt.Errorf("failed to convert %T to %T: %w", v, result, err)
+	}
+	return result, nil
+}
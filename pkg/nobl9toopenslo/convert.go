@@ -0,0 +1,208 @@
+package nobl9toopenslo
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/nobl9/nobl9-openslo/internal/annotations"
+	"github.com/nobl9/nobl9-openslo/internal/jsonpath"
+)
+
+const defaultProject = "default"
+
+func Convert(objects []manifest.Object) ([]openslo.Object, error) {
+	if len(objects) == 0 {
+		return nil, errors.New("no Nobl9 objects provided")
+	}
+
+	opensloJSONObjects := make([]string, 0, len(objects))
+	for _, object := range objects {
+		jsonObjects, err := nobl9ObjectToOpenSLO(object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s %s: %w", object.GetKind(), object.GetName(), err)
+		}
+		opensloJSONObjects = append(opensloJSONObjects, jsonObjects...)
+	}
+	opensloObjects, err := openslosdk.Decode(
+		strings.NewReader("["+strings.Join(opensloJSONObjects, ",")+"]"),
+		openslosdk.FormatJSON,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OpenSLO objects: %w", err)
+	}
+	if err = openslosdk.Validate(opensloObjects...); err != nil {
+		return nil, fmt.Errorf("failed to validate OpenSLO objects: %w", err)
+	}
+	return opensloObjects, nil
+}
+
+func nobl9ObjectToOpenSLO(nobl9Object manifest.Object) (opensloObjects []string, err error) {
+	data, err := json.Marshal(nobl9Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Nobl9 object to JSON: %w", err)
+	}
+	object := gjson.ParseBytes(data)
+
+	walker := jsonpath.NewWalker()
+	walker.Walk(object, "")
+	paths := sortPaths(walker.Paths())
+
+	rules, err := getConversionRules(nobl9Object.GetVersion(), nobl9Object.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	opensloObject := "{}"
+	for _, path := range paths {
+		if !rules.Covers(path.Path) {
+			// Nobl9-only fields are preserved as annotations,
+			// they are applied back to the Nobl9 object by the OpenSLO to Nobl9 converter.
+			if !isLeafValue(path.Value) {
+				continue
+			}
+			opensloObject, err = annotations.AddNobl9ToOpenSLO(opensloObject, path.Path, path.Value)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		opensloObject, err = rules.Convert(opensloObject, path.Path, path.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	opensloObject, err = restoreOpenSLOAnnotations(opensloObject, object.Get("metadata.annotations"))
+	if err != nil {
+		return nil, err
+	}
+	opensloObject, err = removeImplicitProject(opensloObject, object.Get("metadata.annotations"))
+	if err != nil {
+		return nil, err
+	}
+	opensloObject, err = setDefaults(opensloObject)
+	if err != nil {
+		return nil, err
+	}
+	return exportIndicator(opensloObject)
+}
+
+// restoreOpenSLOAnnotations sets the OpenSLO-only fields preserved
+// in the Nobl9 object's annotations by the OpenSLO to Nobl9 converter.
+func restoreOpenSLOAnnotations(opensloObject string, nobl9Annotations gjson.Result) (string, error) {
+	annotationsMap := make(map[string]string)
+	nobl9Annotations.ForEach(func(key, value gjson.Result) bool {
+		annotationsMap[key.String()] = value.String()
+		return true
+	})
+	opensloAnnotations := annotations.GetOpenSLOFromNobl9(annotationsMap)
+	var err error
+	for _, path := range slices.Sorted(maps.Keys(opensloAnnotations)) {
+		if path == "apiVersion" {
+			continue
+		}
+		opensloObject, err = annotations.SetOpenSLOValue(opensloObject, path, opensloAnnotations[path])
+		if err != nil {
+			return "", fmt.Errorf("failed to restore OpenSLO annotation %s: %w", path, err)
+		}
+	}
+	return opensloObject, nil
+}
+
+// removeImplicitProject removes the project annotation if the OpenSLO to Nobl9 converter
+// resolved the project, instead of taking it from the annotation.
+func removeImplicitProject(opensloObject string, nobl9Annotations gjson.Result) (string, error) {
+	if _, ok := nobl9Annotations.Map()[annotations.ImplicitProjectKey]; !ok {
+		return opensloObject, nil
+	}
+	opensloObject, err := sjson.Delete(opensloObject, `metadata.annotations.nobl9\.com/metadata\.project`)
+	if err != nil {
+		return "", err
+	}
+	if len(gjson.Get(opensloObject, "metadata.annotations").Map()) == 0 {
+		return sjson.Delete(opensloObject, "metadata.annotations")
+	}
+	return opensloObject, nil
+}
+
+// exportIndicator extracts inlined indicator into a separate SLI object
+// if the SLO originally referenced it through 'spec.indicatorRef'.
+func exportIndicator(opensloObject string) ([]string, error) {
+	indicatorRef := gjson.Get(opensloObject, "spec.indicatorRef")
+	indicator := gjson.Get(opensloObject, "spec.indicator")
+	if !indicatorRef.Exists() || !indicator.Exists() {
+		return []string{opensloObject}, nil
+	}
+	sli := `{"apiVersion":"` + openslo.VersionV1.String() + `","kind":"` + openslo.KindSLI.String() + `"}`
+	sli, err := sjson.SetRaw(sli, "metadata", indicator.Get("metadata").Raw)
+	if err != nil {
+		return nil, err
+	}
+	if sli, err = sjson.Set(sli, "metadata.name", indicatorRef.String()); err != nil {
+		return nil, err
+	}
+	if sli, err = sjson.SetRaw(sli, "spec", indicator.Get("spec").Raw); err != nil {
+		return nil, err
+	}
+	if opensloObject, err = sjson.Delete(opensloObject, "spec.indicator"); err != nil {
+		return nil, err
+	}
+	return []string{opensloObject, sli}, nil
+}
+
+func isLeafValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case map[string]any, []any:
+		return false
+	default:
+		return true
+	}
+}
+
+type pathTuple struct {
+	Path  string
+	Value any
+}
+
+func sortPaths(pathsMap map[string]any) []pathTuple {
+	// The first item from this list will be the last in the result.
+	reversePrecedence := map[string]func(s1, s2 string) bool{
+		"spec.indicator": strings.HasPrefix,
+	}
+
+	keys := slices.SortedFunc(maps.Keys(pathsMap), func(s1, s2 string) int {
+		for p, cmpFunc := range reversePrecedence {
+			cmp1, cmp2 := cmpFunc(s1, p), cmpFunc(s2, p)
+			if cmp1 && !cmp2 {
+				return 1
+			}
+			if !cmp1 && cmp2 {
+				return -1
+			}
+		}
+		return cmp.Compare(s1, s2)
+	})
+
+	result := make([]pathTuple, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, pathTuple{
+			Path:  key,
+			Value: pathsMap[key],
+		})
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+package nobl9toopenslo
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+	"github.com/goccy/go-yaml"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertpolicy"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/project"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
+	"github.com/nobl9/nobl9-go/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	inputsDir  = "./test_data/inputs/"
+	outputsDir = "./test_data/outputs/"
+)
+
+func TestConvert(t *testing.T) {
+	inputs := listAllFilesInDir(t, inputsDir)
+	outputs := listAllFilesInDir(t, outputsDir)
+	require.Len(t, inputs, len(outputs))
+
+	for _, fileName := range inputs {
+		t.Run(fileName, func(t *testing.T) {
+			inputFileData, err := os.ReadFile(filepath.Join(inputsDir, fileName))
+			require.NoError(t, err)
+
+			outputsFileData, err := os.ReadFile(filepath.Join(outputsDir, fileName))
+			require.NoError(t, err)
+			expectedObjects, err := openslosdk.Decode(bytes.NewReader(outputsFileData), openslosdk.FormatYAML)
+			require.NoError(t, err)
+			require.NoError(t, openslosdk.Validate(expectedObjects...), "failed to validate OpenSLO objects")
+
+			nobl9Objects, err := sdk.DecodeObjects(inputFileData)
+			require.NoError(t, err)
+
+			actual, err := Convert(nobl9Objects)
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			err = openslosdk.Encode(&buf, openslosdk.FormatJSON, actual...)
+			require.NoError(t, err)
+
+			expectedJSON, err := yaml.YAMLToJSON(outputsFileData)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expectedJSON), buf.String())
+		})
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	tests := map[string]struct {
+		objects []manifest.Object
+		error   string
+	}{
+		"no objects": {
+			error: "no Nobl9 objects provided",
+		},
+		"unsupported kind": {
+			objects: []manifest.Object{project.New(project.Metadata{Name: "test"}, project.Spec{})},
+			error:   "failed to convert Project test: unsupported kind Project for version n9/v1alpha",
+		},
+		"unsupported measurement": {
+			objects: []manifest.Object{alertpolicy.New(
+				alertpolicy.Metadata{Name: "test", Project: "default"},
+				alertpolicy.Spec{
+					Severity: alertpolicy.SeverityHigh.String(),
+					Conditions: []alertpolicy.AlertCondition{
+						{
+							Measurement:    "burnRate",
+							Value:          1.0,
+							AlertingWindow: "1h",
+						},
+					},
+				},
+			)},
+			error: "failed to convert AlertPolicy test: unsupported measurement 'burnRate' for spec.conditions.0",
+		},
+		"missing alerting window": {
+			objects: []manifest.Object{alertpolicy.New(
+				alertpolicy.Metadata{Name: "test", Project: "default"},
+				alertpolicy.Spec{
+					Severity: alertpolicy.SeverityHigh.String(),
+					Conditions: []alertpolicy.AlertCondition{
+						{
+							Measurement:      alertpolicy.MeasurementTimeToBurnBudget.String(),
+							Value:            "1h",
+							LastsForDuration: "5m",
+						},
+					},
+				},
+			)},
+			error: "failed to convert AlertPolicy test:" +
+				" spec.conditions.0.alertingWindow is required to define OpenSLO lookbackWindow",
+		},
+		"invalid time to burn value": {
+			objects: []manifest.Object{alertpolicy.New(
+				alertpolicy.Metadata{Name: "test", Project: "default"},
+				alertpolicy.Spec{
+					Severity: alertpolicy.SeverityHigh.String(),
+					Conditions: []alertpolicy.AlertCondition{
+						{
+							Measurement:    alertpolicy.MeasurementTimeToBurnEntireBudget.String(),
+							Value:          2.0,
+							AlertingWindow: "1h",
+						},
+					},
+				},
+			)},
+			error: "failed to convert AlertPolicy test: invalid type for spec.conditions.0.value," +
+				" expected string, got float64",
+		},
+		"multiple alert conditions": {
+			objects: []manifest.Object{alertpolicy.New(
+				alertpolicy.Metadata{Name: "test", Project: "default"},
+				alertpolicy.Spec{
+					Severity: alertpolicy.SeverityHigh.String(),
+					Conditions: []alertpolicy.AlertCondition{
+						{
+							Measurement:    alertpolicy.MeasurementAverageBurnRate.String(),
+							Value:          1.0,
+							AlertingWindow: "1h",
+						},
+						{
+							Measurement:    alertpolicy.MeasurementAverageBurnRate.String(),
+							Value:          2.0,
+							AlertingWindow: "5m",
+						},
+					},
+				},
+			)},
+			error: "failed to convert AlertPolicy test: spec.conditions.1 is not supported," +
+				" OpenSLO AlertPolicy can only define a single condition",
+		},
+		"objectives with different metrics": {
+			objects: []manifest.Object{slo.New(
+				slo.Metadata{Name: "test", Project: "default"},
+				slo.Spec{
+					Service:         "web",
+					BudgetingMethod: slo.BudgetingMethodOccurrences.String(),
+					Indicator: &slo.Indicator{
+						MetricSource: slo.MetricSourceSpec{Name: "prometheus"},
+					},
+					Objectives: []slo.Objective{
+						{
+							ObjectiveBase: slo.ObjectiveBase{DisplayName: "Good", Value: ptr(1.0)},
+							BudgetTarget:  ptr(0.9),
+							Operator:      ptr(v1alpha.LessThan.String()),
+							RawMetric: &slo.RawMetricSpec{MetricQuery: &slo.MetricSpec{
+								Prometheus: &slo.PrometheusMetric{PromQL: ptr("latency_a")},
+							}},
+						},
+						{
+							ObjectiveBase: slo.ObjectiveBase{DisplayName: "Ok", Value: ptr(2.0)},
+							BudgetTarget:  ptr(0.99),
+							Operator:      ptr(v1alpha.LessThan.String()),
+							RawMetric: &slo.RawMetricSpec{MetricQuery: &slo.MetricSpec{
+								Prometheus: &slo.PrometheusMetric{PromQL: ptr("latency_b")},
+							}},
+						},
+					},
+					TimeWindows: []slo.TimeWindow{{Unit: "Day", Count: 7, IsRolling: true}},
+				},
+			)},
+			error: "failed to convert SLO test: spec.objectives.1.rawMetric.query differs between objectives," +
+				" OpenSLO SLO can only define a single indicator for all objectives",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Convert(tc.objects)
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.error)
+		})
+	}
+}
+
+func listAllFilesInDir(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	return files
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,35 @@
+package nobl9toopenslo
+
+import (
+	"fmt"
+
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// nobl9TimeSliceWindow is the fixed time slice window used by Nobl9 SLOs with Timeslices budgeting method.
+var nobl9TimeSliceWindow = v1.NewDurationShorthand(1, v1.DurationShorthandUnitMinute)
+
+func setDefaults(jsonObject string) (result string, err error) {
+	if gjson.Get(jsonObject, "spec.indicator").Exists() &&
+		gjson.Get(jsonObject, "spec.indicator.metadata.name").String() == "" {
+		jsonObject, err = sjson.Set(jsonObject, "spec.indicator.metadata.name", gjson.Get(jsonObject, "metadata.name").String())
+		if err != nil {
+			return "", fmt.Errorf("failed to set spec.indicator.metadata.name to SLO name: %w", err)
+		}
+	}
+	if gjson.Get(jsonObject, "spec.budgetingMethod").String() == string(v1.SLOBudgetingMethodTimeslices) {
+		for i, objective := range gjson.Get(jsonObject, "spec.objectives").Array() {
+			if objective.Get("timeSliceWindow").Exists() {
+				continue
+			}
+			path := fmt.Sprintf("spec.objectives.%d.timeSliceWindow", i)
+			jsonObject, err = sjson.Set(jsonObject, path, nobl9TimeSliceWindow.String())
+			if err != nil {
+				return "", fmt.Errorf("failed to set %s to %s: %w", path, nobl9TimeSliceWindow, err)
+			}
+		}
+	}
+	return jsonObject, nil
+}
@@ -0,0 +1,173 @@
+package openslotonobl9
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertpolicy"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/nobl9/nobl9-openslo/internal/annotations"
+)
+
+// conditionMeasurementAnnotation is the AlertCondition annotation which selects
+// the Nobl9 measurement the OpenSLO 'burnrate' condition is converted to.
+const conditionMeasurementAnnotation = DomainNobl9 + "/measurement"
+
+// supportedMeasurements lists Nobl9 measurements which OpenSLO 'burnrate' condition can be converted to.
+var supportedMeasurements = []alertpolicy.Measurement{
+	alertpolicy.MeasurementAverageBurnRate,
+	alertpolicy.MeasurementBurnedBudget,
+	alertpolicy.MeasurementTimeToBurnBudget,
+	alertpolicy.MeasurementTimeToBurnEntireBudget,
+}
+
+// Condition conversion functions rely on the paths being converted in lexicographical order.
+// The measurement is set from 'metadata.annotations' or 'spec.condition.kind',
+// before 'spec.condition.lookbackWindow', 'spec.condition.op' and 'spec.condition.threshold'
+// are converted according to it.
+
+func convertConditionAnnotations(jsonObject, path string, v any) (updatedJSON string, err error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected map[string]any, got %T", path, v)
+	}
+	for key, value := range m {
+		if key != conditionMeasurementAnnotation {
+			jsonObject, err = annotations.AddOpenSLOToNobl9(jsonObject, path+"."+key, value)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+		measurement, err := parseConditionMeasurement(value)
+		if err != nil {
+			return "", err
+		}
+		jsonObject, err = sjson.Set(jsonObject, nobl9ConditionPath(path)+".measurement", measurement.String())
+		if err != nil {
+			return "", err
+		}
+	}
+	return jsonObject, nil
+}
+
+func parseConditionMeasurement(v any) (alertpolicy.Measurement, error) {
+	names := make([]string, 0, len(supportedMeasurements))
+	for _, measurement := range supportedMeasurements {
+		if v == measurement.String() {
+			return measurement, nil
+		}
+		names = append(names, measurement.String())
+	}
+	return 0, fmt.Errorf("unsupported '%s' annotation value '%v', must be one of: %s",
+		conditionMeasurementAnnotation, v, strings.Join(names, ", "))
+}
+
+func convertConditionKind(jsonObject, path string, v any) (updatedJSON string, err error) {
+	kind, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	if kind != "burnrate" {
+		return "", fmt.Errorf("unsupported condition kind '%s', only 'burnrate' is supported", kind)
+	}
+	measurementPath := nobl9ConditionPath(path) + ".measurement"
+	if gjson.Get(jsonObject, measurementPath).Exists() {
+		return jsonObject, nil
+	}
+	return sjson.Set(jsonObject, measurementPath, alertpolicy.MeasurementAverageBurnRate.String())
+}
+
+// convertConditionOperator ensures the operator is the one Nobl9 expects for the measurement.
+// Nobl9 accepts any operator for 'burnedBudget'.
+func convertConditionOperator(jsonObject, path string, v any) (updatedJSON string, err error) {
+	operator, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	measurement, err := getConditionMeasurement(jsonObject, path)
+	if err != nil {
+		return "", err
+	}
+	if measurement != alertpolicy.MeasurementBurnedBudget {
+		expected, err := alertpolicy.GetDefaultOperatorForMeasurement(measurement)
+		if err != nil {
+			return "", err
+		}
+		if operator != expected.String() {
+			return "", fmt.Errorf("measurement '%s' requires '%s' operator, got '%s'", measurement, expected, operator)
+		}
+	}
+	return sjson.Set(jsonObject, nobl9ConditionPath(path)+".op", operator)
+}
+
+// convertConditionThreshold sets the condition value, time to burn measurements
+// interpret the threshold as a number of hours and expect a duration string.
+func convertConditionThreshold(jsonObject, path string, v any) (updatedJSON string, err error) {
+	threshold, ok := v.(float64)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected number, got %T", path, v)
+	}
+	measurement, err := getConditionMeasurement(jsonObject, path)
+	if err != nil {
+		return "", err
+	}
+	var value any = threshold
+	switch measurement {
+	case alertpolicy.MeasurementTimeToBurnBudget, alertpolicy.MeasurementTimeToBurnEntireBudget:
+		duration := time.Duration(threshold * float64(time.Hour)).Round(time.Second)
+		if duration <= 0 {
+			return "", fmt.Errorf("measurement '%s' requires a positive threshold, got %v", measurement, threshold)
+		}
+		value = formatDuration(duration)
+	}
+	return sjson.Set(jsonObject, nobl9ConditionPath(path)+".value", value)
+}
+
+// convertConditionLookbackWindow sets the condition alerting window,
+// Nobl9 does not support it for 'burnedBudget', in that case it is preserved as an annotation.
+func convertConditionLookbackWindow(jsonObject, path string, v any) (updatedJSON string, err error) {
+	measurement, err := getConditionMeasurement(jsonObject, path)
+	if err != nil {
+		return "", err
+	}
+	if measurement == alertpolicy.MeasurementBurnedBudget {
+		return annotations.AddOpenSLOToNobl9(jsonObject, path, v)
+	}
+	return convertConditionDuration(jsonObject, path, "alertingWindow", v)
+}
+
+func convertConditionAlertAfter(jsonObject, path string, v any) (updatedJSON string, err error) {
+	return convertConditionDuration(jsonObject, path, "lastsFor", v)
+}
+
+// convertConditionDuration sets the OpenSLO duration shorthand as Nobl9 duration at the condition field.
+func convertConditionDuration(jsonObject, path, field string, v any) (updatedJSON string, err error) {
+	value, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+	}
+	duration, err := durationShorthandToNobl9Duration(value)
+	if err != nil {
+		return "", err
+	}
+	return sjson.Set(jsonObject, nobl9ConditionPath(path)+"."+field, duration)
+}
+
+func getConditionMeasurement(jsonObject, path string) (alertpolicy.Measurement, error) {
+	value := gjson.Get(jsonObject, nobl9ConditionPath(path)+".measurement").String()
+	if value == "" {
+		return alertpolicy.MeasurementAverageBurnRate, nil
+	}
+	return alertpolicy.ParseMeasurement(value)
+}
+
+// nobl9ConditionPath returns the Nobl9 condition path for the OpenSLO condition path,
+// e.g. 'spec.conditions.0.spec.condition.op' -> 'spec.conditions.0'.
+func nobl9ConditionPath(path string) string {
+	split := strings.SplitN(path, ".", 4)
+	return strings.Join(split[:min(len(split), 3)], ".")
+}
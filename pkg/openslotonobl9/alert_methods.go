@@ -0,0 +1,100 @@
+package openslotonobl9
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertmethod"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// alertMethodRequiredFields lists the fields required to create a Nobl9 AlertMethod.
+// Nobl9 validation does not require them, since their values are hidden when AlertMethod is fetched.
+var alertMethodRequiredFields = map[string][]string{
+	"discord":    {"url"},
+	"jira":       {"url", "username", "apiToken", "projectKey"},
+	"msteams":    {"url"},
+	"opsgenie":   {"auth"},
+	"pagerduty":  {"integrationKey"},
+	"servicenow": {"instanceName"},
+	"slack":      {"url"},
+	"webhook":    {"url"},
+}
+
+// convertNotificationTargetAnnotations converts AlertNotificationTarget annotations.
+// Besides the generic Nobl9 annotations, which define a single field,
+// 'nobl9.com/spec.<type>' annotation can define the whole alert method spec as a JSON object.
+// Single field annotations take precedence over the JSON object.
+func convertNotificationTargetAnnotations(jsonObject, path string, v any) (updatedJSON string, err error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("invalid type for %s, expected map[string]any, got %T", path, v)
+	}
+	alertMethodTypes := getAlertMethodTypes()
+	fieldAnnotations := make(map[string]any, len(m))
+	for key, value := range m {
+		typ, isSpec := strings.CutPrefix(key, nobl9AnnotationPrefix+"spec.")
+		if _, isAlertMethod := alertMethodTypes[typ]; !isSpec || !isAlertMethod {
+			fieldAnnotations[key] = value
+			continue
+		}
+		raw, _ := value.(string)
+		if !gjson.Valid(raw) || !gjson.Parse(raw).IsObject() {
+			return "", fmt.Errorf("'%s' annotation must be a JSON object", key)
+		}
+		jsonObject, err = sjson.SetRaw(jsonObject, "spec."+typ, raw)
+		if err != nil {
+			return "", err
+		}
+	}
+	return convertAnnotations(jsonObject, path, fieldAnnotations)
+}
+
+// validateAlertMethod validates the spec of the Nobl9 AlertMethod converted from the AlertNotificationTarget.
+func validateAlertMethod(object openslo.Object, jsonObject string) error {
+	var missing []string
+	for typ, fields := range alertMethodRequiredFields {
+		spec := gjson.Get(jsonObject, "spec."+typ)
+		if !spec.Exists() {
+			continue
+		}
+		for _, field := range fields {
+			if spec.Get(field).String() == "" {
+				missing = append(missing, fmt.Sprintf("'spec.%s.%s'", typ, field))
+			}
+		}
+		if len(missing) > 0 {
+			return newObjectConversionError(object, "spec.target", fmt.Errorf(
+				"%s AlertMethod requires %s, define them with '%sspec.%s' annotation",
+				typ, strings.Join(missing, ", "), nobl9AnnotationPrefix, typ))
+		}
+	}
+
+	var alertMethod alertmethod.AlertMethod
+	if err := json.Unmarshal([]byte(jsonObject), &alertMethod); err != nil {
+		return newObjectConversionError(object, "spec", fmt.Errorf("failed to decode Nobl9 AlertMethod: %w", err))
+	}
+	err := alertMethod.Validate()
+	var objectErr *v1alpha.ObjectError
+	if !errors.As(err, &objectErr) {
+		return err
+	}
+	// Only the spec is validated, metadata is validated when the converted objects are applied.
+	specErrors := make(govy.PropertyErrors, 0, len(objectErr.Errors))
+	for _, propertyErr := range objectErr.Errors {
+		if strings.HasPrefix(propertyErr.PropertyPath.String(), "spec") {
+			specErrors = append(specErrors, propertyErr)
+		}
+	}
+	if len(specErrors) == 0 {
+		return nil
+	}
+	objectErr.Errors = specErrors
+	return newObjectConversionError(object, "spec", objectErr)
+}
@@ -0,0 +1,140 @@
+package openslotonobl9
+
+import (
+	"fmt"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+const (
+	compositeObjectiveName          = "composite"
+	compositeComponentObjectiveName = "objective-1"
+	compositeMaxDelay               = "15m"
+)
+
+// v2alphaCompositeSLOToNobl9 converts v2alpha SLO with composite objectives into Nobl9 composite SLO.
+//
+// OpenSLO composite objectives define their own SLIs, whereas Nobl9 composite SLO
+// aggregates objectives of other SLOs.
+// Each objective is converted to a separate component SLO named '<slo>-component-<index>',
+// which inherits metadata, service, budgeting method and time window of the composite SLO.
+// The composite SLO defines a single composite objective which references all the components,
+// its target is the lowest target of the components.
+func (c *Converter) v2alphaCompositeSLOToNobl9(
+	composite v2alpha.SLO,
+	project string,
+	dataSources map[string]dataSourceIdentity,
+	report *ConversionReport,
+) ([]string, error) {
+	// Validate before splitting, this way errors point to the composite SLO objectives.
+	if err := validateOpenSLOObject(composite); err != nil {
+		return nil, err
+	}
+	components := make([]slo.CompositeObjective, 0, len(composite.Spec.Objectives))
+	result := make([]string, 0, len(composite.Spec.Objectives)+1)
+	var target *float64
+	for i, objective := range composite.Spec.Objectives {
+		component := v2alpha.NewSLO(
+			v2alpha.Metadata{
+				Name:        fmt.Sprintf("%s-component-%d", composite.Metadata.Name, i+1),
+				Labels:      composite.Metadata.Labels,
+				Annotations: composite.Metadata.Annotations,
+			},
+			v2alpha.SLOSpec{
+				Description:     composite.Spec.Description,
+				ServiceRef:      composite.Spec.ServiceRef,
+				SLI:             objective.SLI,
+				BudgetingMethod: composite.Spec.BudgetingMethod,
+				TimeWindow:      composite.Spec.TimeWindow,
+				Objectives:      []v2alpha.SLOObjective{compositeComponentObjective(objective)},
+			},
+		)
+		resolved, err := c.resolveMetricSource(component, dataSources, report)
+		if err != nil {
+			return nil, err
+		}
+		jsonObject, err := c.opensloObjectToNobl9(resolved, project, report)
+		if err != nil {
+			return nil, err
+		}
+		jsonObject, err = sjson.Set(jsonObject, "spec.objectives.0.name", compositeComponentObjectiveName)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, jsonObject)
+
+		weight := 1.0
+		if objective.CompositeWeight != nil {
+			weight = *objective.CompositeWeight
+		} else if err = c.warn(report, newWarning(composite, fmt.Sprintf("spec.objectives.%d.compositeWeight", i),
+			WarningCodeDefaultedValue, "composite weight was not provided, defaulted to 1")); err != nil {
+			return nil, err
+		}
+		components = append(components, slo.CompositeObjective{
+			Project:     gjson.Get(jsonObject, "metadata.project").String(),
+			SLO:         component.Metadata.Name,
+			Objective:   compositeComponentObjectiveName,
+			Weight:      weight,
+			WhenDelayed: slo.WhenDelayedCountAsGood,
+		})
+		if componentTarget := objectiveTarget(objective); componentTarget != nil &&
+			(target == nil || *componentTarget < *target) {
+			target = componentTarget
+		}
+	}
+
+	if err := c.warn(report, newWarning(composite, "spec.objectives", WarningCodeLossyMapping,
+		"objectives were converted to component SLOs, composite SLO target is the lowest target of the objectives",
+	)); err != nil {
+		return nil, err
+	}
+	if err := c.warn(report, newWarning(composite, "spec.objectives", WarningCodeDefaultedValue,
+		fmt.Sprintf("composite max delay was defaulted to '%s'", compositeMaxDelay),
+	)); err != nil {
+		return nil, err
+	}
+
+	parent := composite
+	parent.Spec.Objectives = nil
+	jsonObject, err := c.opensloObjectToNobl9(parent, project, report)
+	if err != nil {
+		return nil, err
+	}
+	jsonObject, err = sjson.Set(jsonObject, "spec.objectives.0", map[string]any{
+		"name":   compositeObjectiveName,
+		"target": target,
+		"composite": map[string]any{
+			"maxDelay": compositeMaxDelay,
+			"components": map[string]any{
+				"objectives": components,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Components are listed first, they must exist before the composite SLO is applied.
+	return append(result, jsonObject), nil
+}
+
+func compositeComponentObjective(objective v2alpha.SLOObjective) v2alpha.SLOObjective {
+	objective.SLI = nil
+	objective.SLIRef = nil
+	objective.CompositeWeight = nil
+	return objective
+}
+
+func objectiveTarget(objective v2alpha.SLOObjective) *float64 {
+	switch {
+	case objective.Target != nil:
+		return objective.Target
+	case objective.TargetPercent != nil:
+		target := *objective.TargetPercent / 100
+		return &target
+	default:
+		return nil
+	}
+}
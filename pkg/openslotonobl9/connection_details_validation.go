@@ -0,0 +1,88 @@
+package openslotonobl9
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/govy/pkg/jsonpath"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/agent"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/direct"
+)
+
+const connectionDetailsPath = "spec.connectionDetails"
+
+// validateConnectionDetails decodes v1 and v2alpha DataSource 'spec.connectionDetails' into
+// the Nobl9 Agent or Direct config of the DataSource type and validates it with Nobl9 validation.
+// Nobl9 reports errors at the paths of its own object, for instance 'spec.prometheus.url',
+// they are reported at the DataSource paths instead, for instance 'spec.connectionDetails.url'.
+// DataSources of unknown types are not validated, their type is reported by [opensloObjectValidation].
+func validateConnectionDetails(object openslo.Object) govy.PropertyErrors {
+	var (
+		typ               string
+		connectionDetails json.RawMessage
+		annotations       map[string]string
+	)
+	switch v := object.(type) {
+	case v1.DataSource:
+		typ, connectionDetails, annotations = v.Spec.Type, v.Spec.ConnectionDetails, v.Metadata.Annotations
+	case v2alpha.DataSource:
+		typ, connectionDetails, annotations = v.Spec.Type, v.Spec.ConnectionDetails, v.Metadata.Annotations
+	default:
+		return nil
+	}
+	// Missing connection details are validated as empty, this way required fields are reported.
+	var value any = map[string]any{}
+	if len(connectionDetails) > 0 {
+		if err := json.Unmarshal(connectionDetails, &value); err != nil {
+			return govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(connectionDetailsPath), nil, err)}
+		}
+	}
+	if annotations[DomainNobl9+"/kind"] == manifest.KindDirect.String() {
+		spec, errs := decodeNobl9Spec[direct.Spec](typ, value, connectionDetailsPath,
+			fmt.Sprintf("'%s' Direct config", typ))
+		if spec == nil {
+			return errs
+		}
+		nobl9Direct := direct.New(direct.Metadata{Name: "connection-details", Project: defaultProject}, *spec)
+		return mapConnectionDetailsErrors(nobl9Direct.GetValidator().Validate(nobl9Direct), typ)
+	}
+	spec, errs := decodeNobl9Spec[agent.Spec](typ, value, connectionDetailsPath,
+		fmt.Sprintf("'%s' Agent config", typ))
+	if spec == nil {
+		return errs
+	}
+	nobl9Agent := agent.New(agent.Metadata{Name: "connection-details", Project: defaultProject}, *spec)
+	return mapConnectionDetailsErrors(nobl9Agent.GetValidator().Validate(nobl9Agent), typ)
+}
+
+// mapConnectionDetailsErrors maps the paths of Nobl9 Agent or Direct validation errors
+// of the given type config, 'spec.<type>', to 'spec.connectionDetails'.
+// Errors of other properties are not related to the connection details and are skipped.
+func mapConnectionDetailsErrors(err error, typ string) govy.PropertyErrors {
+	var validatorErr *govy.ValidatorError
+	if !errors.As(err, &validatorErr) {
+		if err == nil {
+			return nil
+		}
+		return govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(connectionDetailsPath), nil, err)}
+	}
+	prefix := "spec." + typ
+	var errs govy.PropertyErrors
+	for _, propertyErr := range validatorErr.Errors {
+		rest, ok := strings.CutPrefix(propertyErr.PropertyPath.String(), prefix)
+		if !ok || (rest != "" && !strings.HasPrefix(rest, ".") && !strings.HasPrefix(rest, "[")) {
+			continue
+		}
+		mapped := *propertyErr
+		mapped.PropertyPath = jsonpath.Parse(joinPath(connectionDetailsPath, strings.TrimPrefix(rest, ".")))
+		errs = append(errs, &mapped)
+	}
+	return errs
+}
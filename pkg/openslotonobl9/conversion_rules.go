@@ -2,21 +2,30 @@ package openslotonobl9
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 	"github.com/nobl9/nobl9-go/manifest"
 	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertpolicy"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
 	"github.com/nobl9/nobl9-go/manifest/v1alpha/twindow"
 	"github.com/tidwall/sjson"
 
-	"github.com/nobl9/nobl9-openslo/internal/conversionrules"
+	"github.com/nobl9/nobl9-openslo/internal/annotations"
 	"github.com/nobl9/nobl9-openslo/internal/jsonpath"
+	"github.com/nobl9/nobl9-openslo/pkg/conversionrules"
 )
 
+var errUnsupportedKind = errors.New("unsupported kind")
+
 func getConversionRules(version openslo.Version, kind openslo.Kind) (conversionrules.Rules, error) {
 	switch version {
 	case openslo.VersionV1:
@@ -36,13 +45,53 @@ func getConversionRules(version openslo.Version, kind openslo.Kind) (conversionr
 		case openslo.KindAlertCondition:
 			return nil, nil
 		default:
-			return nil, fmt.Errorf("unsupported kind %s for version %s", kind, version)
+			return nil, fmt.Errorf("%w %s for version %s", errUnsupportedKind, kind, version)
+		}
+	case openslo.VersionV1alpha:
+		switch kind {
+		case openslo.KindSLO:
+			return mergeConversionRules(v1alphaCommonRules, v1alphaSLORules), nil
+		case openslo.KindService:
+			return v1alphaCommonRules, nil
+		default:
+			return nil, fmt.Errorf("%w %s for version %s", errUnsupportedKind, kind, version)
+		}
+	case openslo.VersionV2alpha:
+		switch kind {
+		case openslo.KindSLO:
+			return mergeConversionRules(v2alphaCommonRules, v2alphaSLORules), nil
+		case openslo.KindService:
+			return v2alphaCommonRules, nil
+		case openslo.KindDataSource:
+			return mergeConversionRules(v2alphaCommonRules, v1DataSourceRules), nil
+		case openslo.KindAlertPolicy:
+			return mergeConversionRules(v2alphaCommonRules, v1AlertPolicyRules, v2alphaAlertPolicyRules), nil
+		case openslo.KindAlertNotificationTarget:
+			return mergeConversionRules(v2alphaCommonRules, v1AlertNotificationTargetRules), nil
+		case openslo.KindSLI:
+			return nil, nil
+		case openslo.KindAlertCondition:
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("%w %s for version %s", errUnsupportedKind, kind, version)
 		}
 	default:
 		return nil, fmt.Errorf("unsupported API version %s", version)
 	}
 }
 
+// getConversionRules returns the built-in conversion rules merged with the rules set with [WithConversionRules].
+func (c *Converter) getConversionRules(version openslo.Version, kind openslo.Kind) (conversionrules.Rules, error) {
+	rules, err := getConversionRules(version, kind)
+	if err != nil || len(rules) == 0 {
+		return rules, err
+	}
+	if overrides, ok := c.conversionRules[conversionRulesKey{version: version, kind: kind}]; ok {
+		rules = mergeConversionRules(rules, overrides)
+	}
+	return rules, nil
+}
+
 var v1CommonRules = conversionrules.Rules{
 	"apiVersion": conversionrules.Value(func(v any) (any, error) {
 		return manifest.VersionV1alpha.String(), nil
@@ -72,9 +121,7 @@ var v1SLORules = conversionrules.Rules{
 	"spec.objectives.#.target":                           conversionrules.Direct(),
 	"spec.objectives.#.op":                               conversionrules.Direct(),
 	"spec.objectives.#.value":                            conversionrules.Direct(),
-	"spec.timeWindow.0.duration":                         conversionrules.Custom(convertSLOTimeWindowDuration),
-	"spec.timeWindow.0.isRolling":                        conversionrules.Path("spec.timeWindows.0.isRolling"),
-	"spec.timeWindow.0.calendar":                         conversionrules.Path("spec.timeWindows.0.calendar"),
+	"spec.timeWindow.0":                                  conversionrules.Custom(convertSLOTimeWindow),
 }
 
 var v1DataSourceRules = conversionrules.Rules{
@@ -84,25 +131,85 @@ var v1DataSourceRules = conversionrules.Rules{
 	"spec": conversionrules.Custom(convertDataSourceSpec),
 }
 
-// TODO:
-// - Ensure only one severity is set per whole alert policy.
+// OpenSLO AlertPolicy has exactly one condition, its severity becomes the Nobl9 AlertPolicy severity.
+// The condition measurement is selected with 'nobl9.com/measurement' condition annotation.
 var v1AlertPolicyRules = conversionrules.Rules{
-	"spec.conditions.#.spec.severity":                 conversionrules.Path("spec.severity"),
-	"spec.conditions.#.spec.condition.op":             conversionrules.PathIndex("spec.conditions.%d.op"),
+	"metadata.annotations":                            conversionrules.Custom(convertAlertPolicyAnnotations),
+	"spec.conditions.#.kind":                          conversionrules.Noop(),
+	"spec.conditions.#.metadata.annotations":          conversionrules.Custom(convertConditionAnnotations),
+	"spec.conditions.#.spec.severity":                 conversionrules.Custom(convertSeverity),
+	"spec.conditions.#.spec.condition.op":             conversionrules.Custom(convertConditionOperator),
 	"spec.conditions.#.spec.condition.kind":           conversionrules.Custom(convertConditionKind),
-	"spec.conditions.#.spec.condition.threshold":      conversionrules.PathIndex("spec.conditions.%d.value"),
-	"spec.conditions.#.spec.condition.lookbackWindow": conversionrules.PathIndex("spec.conditions.%d.alertingWindow"),
-	"spec.conditions.#.spec.condition.alertAfter":     conversionrules.PathIndex("spec.conditions.%d.lastsFor"),
+	"spec.conditions.#.spec.condition.threshold":      conversionrules.Custom(convertConditionThreshold),
+	"spec.conditions.#.spec.condition.lookbackWindow": conversionrules.Custom(convertConditionLookbackWindow),
+	"spec.conditions.#.spec.condition.alertAfter":     conversionrules.Custom(convertConditionAlertAfter),
 	"spec.notificationTargets.#.targetRef":            conversionrules.PathIndex("spec.alertMethods.%d.metadata.name"),
 }
 
 var v1AlertNotificationTargetRules = conversionrules.Rules{
+	"metadata.annotations": conversionrules.Custom(convertNotificationTargetAnnotations),
 	"kind": conversionrules.Value(func(any) (any, error) {
 		return manifest.KindAlertMethod.String(), nil
 	}),
 	"spec.target": conversionrules.Custom(convertNotificationTarget),
 }
 
+var v1alphaCommonRules = conversionrules.Rules{
+	"apiVersion": conversionrules.Value(func(v any) (any, error) {
+		return manifest.VersionV1alpha.String(), nil
+	}),
+	"kind":                 conversionrules.Direct(),
+	"metadata.name":        conversionrules.Direct(),
+	"metadata.displayName": conversionrules.Direct(),
+	"spec.description":     conversionrules.Direct(),
+}
+
+// nolint: lll
+var v1alphaSLORules = conversionrules.Rules{
+	"spec.service":                               conversionrules.Direct(),
+	"spec.budgetingMethod":                       conversionrules.Direct(),
+	"spec.indicator.thresholdMetric":             conversionrules.Custom(convertV1alphaSLOMetricSource),
+	"spec.objectives.#.displayName":              conversionrules.Direct(),
+	"spec.objectives.#.timeSliceTarget":          conversionrules.Direct(),
+	"spec.objectives.#.target":                   conversionrules.Direct(),
+	"spec.objectives.#.op":                       conversionrules.Direct(),
+	"spec.objectives.#.value":                    conversionrules.Direct(),
+	"spec.objectives.#.ratioMetrics.incremental": conversionrules.PathIndex("spec.objectives.%d.countMetrics.incremental"),
+	"spec.objectives.#.ratioMetrics.good":        conversionrules.Custom(convertV1alphaSLOMetricSource),
+	"spec.objectives.#.ratioMetrics.total":       conversionrules.Custom(convertV1alphaSLOMetricSource),
+	"spec.timeWindows.0":                         conversionrules.Custom(convertV1alphaSLOTimeWindow),
+}
+
+var v2alphaCommonRules = mergeConversionRules(v1CommonRules, conversionrules.Rules{
+	"metadata.labels": conversionrules.Custom(convertV2alphaLabels),
+})
+
+// nolint: lll
+var v2alphaSLORules = conversionrules.Rules{
+	"spec.serviceRef":                   conversionrules.Path("spec.service"),
+	"spec.budgetingMethod":              conversionrules.Direct(),
+	"spec.sli.metadata.name":            conversionrules.Annotation(),
+	"spec.sli.spec.ratioMetric.counter": conversionrules.Path("spec.objectives.#.countMetrics.incremental"),
+	"spec.sli.spec.ratioMetric.total":   conversionrules.Custom(convertV2alphaSLOMetricSpec(sliMetricTypeTotal)),
+	"spec.sli.spec.ratioMetric.good":    conversionrules.Custom(convertV2alphaSLOMetricSpec(sliMetricTypeGood)),
+	"spec.sli.spec.ratioMetric.bad":     conversionrules.Custom(convertV2alphaSLOMetricSpec(sliMetricTypeBad)),
+	"spec.sli.spec.thresholdMetric":     conversionrules.Custom(convertV2alphaSLOMetricSpec(sliMetricTypeRaw)),
+	"spec.objectives.#.displayName":     conversionrules.Direct(),
+	"spec.objectives.#.timeSliceTarget": conversionrules.Direct(),
+	"spec.objectives.#.timeSliceWindow": conversionrules.Direct(),
+	"spec.objectives.#.target":          conversionrules.Direct(),
+	"spec.objectives.#.targetPercent":   conversionrules.Custom(convertV2alphaTargetPercent),
+	"spec.objectives.#.op":              conversionrules.Direct(),
+	"spec.objectives.#.value":           conversionrules.Direct(),
+	"spec.timeWindow.0":                 conversionrules.Custom(convertSLOTimeWindow),
+}
+
+// v2alpha requires both lookbackWindow and alertAfter, while Nobl9 allows only one of
+// alertingWindow and lastsFor, alertAfter is therefore preserved as an annotation.
+var v2alphaAlertPolicyRules = conversionrules.Rules{
+	"spec.conditions.#.spec.condition.alertAfter": conversionrules.Annotation(),
+}
+
 const nobl9AnnotationPrefix = "nobl9.com/"
 
 func convertAnnotations(jsonObject, path string, v any) (updatedJSON string, err error) {
@@ -128,42 +235,158 @@ func convertAnnotations(jsonObject, path string, v any) (updatedJSON string, err
 	return jsonObject, nil
 }
 
-func convertSLOTimeWindowDuration(jsonObject, path string, v any) (updatedJSON string, err error) {
-	duration, ok := v.(string)
+// convertV2alphaLabels converts v2alpha labels, which hold a single value per key,
+// to Nobl9 labels which hold a list of values.
+func convertV2alphaLabels(jsonObject, path string, v any) (updatedJSON string, err error) {
+	labels, err := anyToType[map[string]string](v)
+	if err != nil {
+		return "", err
+	}
+	for key, value := range labels {
+		// Escape dots in the key to avoid interpreting them as a path.
+		key = strings.ReplaceAll(key, ".", "\\.")
+		jsonObject, err = sjson.Set(jsonObject, path+"."+key, []string{value})
+		if err != nil {
+			return "", err
+		}
+	}
+	return jsonObject, nil
+}
+
+func convertV2alphaTargetPercent(jsonObject, path string, v any) (updatedJSON string, err error) {
+	targetPercent, ok := v.(float64)
 	if !ok {
-		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
+		return "", fmt.Errorf("invalid type for %s, expected float64, got %T", path, v)
 	}
-	parsedDuration, err := v1.ParseDurationShorthand(duration)
+	return sjson.Set(jsonObject, strings.TrimSuffix(path, "Percent"), targetPercent/100)
+}
+
+// convertSLOTimeWindow converts v1 and v2alpha time window to Nobl9 time window.
+// Nobl9 SLO has a single time window, OpenSLO validation accepts only one window as well.
+func convertSLOTimeWindow(jsonObject, path string, v any) (updatedJSON string, err error) {
+	timeWindow, err := anyToType[v1.SLOTimeWindow](v)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse %s as %T: %w", duration, parsedDuration, err)
+		return "", err
 	}
-	unit, value := durationShorthandUnitToTimeWindowUnit(parsedDuration)
-	jsonObject, err = sjson.Set(jsonObject, "spec.timeWindows.0.unit", unit)
+	unit, count, err := durationShorthandToTimeWindow(timeWindow.Duration, timeWindow.IsRolling)
 	if err != nil {
 		return "", err
 	}
-	return sjson.Set(jsonObject, "spec.timeWindows.0.count", value)
+	values := map[string]any{
+		"unit":      unit,
+		"count":     count,
+		"isRolling": timeWindow.IsRolling,
+	}
+	if timeWindow.Calendar != nil {
+		calendar, err := convertSLOCalendar(timeWindow.Calendar.StartTime, timeWindow.Calendar.TimeZone)
+		if err != nil {
+			return "", err
+		}
+		values["calendar"] = calendar
+	}
+	return sjson.Set(jsonObject, strings.Replace(path, "spec.timeWindow", "spec.timeWindows", 1), values)
 }
 
-func durationShorthandUnitToTimeWindowUnit(duration v1.DurationShorthand) (unit string, value int) {
+// durationShorthandToTimeWindow converts the duration to Nobl9 time window unit and count.
+// Nobl9 rolling time windows support minutes, hours and days, weeks are converted to days.
+// Calendar-aligned time windows support days, weeks, months, quarters and years.
+func durationShorthandToTimeWindow(
+	duration v1.DurationShorthand,
+	isRolling bool,
+) (unit string, count int, err error) {
+	value := duration.GetValue()
+	if isRolling {
+		switch duration.GetUnit() {
+		case v1.DurationShorthandUnitMinute:
+			return twindow.Minute.String(), value, nil
+		case v1.DurationShorthandUnitHour:
+			return twindow.Hour.String(), value, nil
+		case v1.DurationShorthandUnitDay:
+			return twindow.Day.String(), value, nil
+		case v1.DurationShorthandUnitWeek:
+			return twindow.Day.String(), value * 7, nil
+		}
+		return "", 0, fmt.Errorf("duration unit '%s' is not supported by Nobl9 rolling time window,"+
+			" must be one of: m, h, d, w", duration.GetUnit())
+	}
 	switch duration.GetUnit() {
-	case v1.DurationShorthandUnitMinute:
-		return twindow.Minute.String(), duration.GetValue()
-	case v1.DurationShorthandUnitHour:
-		return twindow.Hour.String(), duration.GetValue()
 	case v1.DurationShorthandUnitDay:
-		return twindow.Day.String(), duration.GetValue()
+		return twindow.Day.String(), value, nil
 	case v1.DurationShorthandUnitWeek:
-		return twindow.Week.String(), duration.GetValue()
+		return twindow.Week.String(), value, nil
 	case v1.DurationShorthandUnitMonth:
-		return twindow.Month.String(), duration.GetValue()
+		return twindow.Month.String(), value, nil
 	case v1.DurationShorthandUnitQuarter:
-		return twindow.Quarter.String(), duration.GetValue()
+		return twindow.Quarter.String(), value, nil
 	case v1.DurationShorthandUnitYear:
-		return twindow.Year.String(), duration.GetValue()
+		return twindow.Year.String(), value, nil
+	}
+	return "", 0, fmt.Errorf("duration unit '%s' is not supported by Nobl9 calendar-aligned time window,"+
+		" must be one of: d, w, M, Q, Y", duration.GetUnit())
+}
+
+// durationShorthandToNobl9Duration converts the duration shorthand to Nobl9 duration string,
+// for instance '1w' becomes '168h'.
+func durationShorthandToNobl9Duration(s string) (string, error) {
+	duration, err := parseDurationShorthand(s)
+	if err != nil {
+		return "", err
+	}
+	return formatDuration(duration), nil
+}
+
+// parseDurationShorthand parses the duration shorthand into [time.Duration].
+// Months, quarters and years have no fixed length and cannot be converted.
+func parseDurationShorthand(s string) (time.Duration, error) {
+	duration, err := v1.ParseDurationShorthand(s)
+	if err != nil {
+		return 0, err
+	}
+	switch duration.GetUnit() {
+	case v1.DurationShorthandUnitMinute,
+		v1.DurationShorthandUnitHour,
+		v1.DurationShorthandUnitDay,
+		v1.DurationShorthandUnitWeek:
 	default:
-		return "", 0
+		return 0, fmt.Errorf("duration unit '%s' cannot be converted to Nobl9 duration,"+
+			" must be one of: m, h, d, w", duration.GetUnit())
+	}
+	if duration.GetValue() < 0 {
+		return 0, fmt.Errorf("duration '%s' must not be negative", s)
+	}
+	return duration.Duration(), nil
+}
+
+// formatDuration formats the duration without trailing zero units, e.g. '72h' instead of '72h0m0s'.
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// convertSLOCalendar parses the calendar start time in its time zone
+// and formats it with the layout expected by Nobl9.
+func convertSLOCalendar(startTime, timeZone string) (*slo.Calendar, error) {
+	if timeZone == "" {
+		return nil, errors.New("calendar time zone is required by Nobl9")
 	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar time zone '%s': %w", timeZone, err)
+	}
+	parsedStartTime, err := time.ParseInLocation(time.DateTime, startTime, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar start time '%s': %w", startTime, err)
+	}
+	return &slo.Calendar{
+		StartTime: parsedStartTime.Format(twindow.IsoDateTimeOnlyLayout),
+		TimeZone:  location.String(),
+	}, nil
 }
 
 type sliMetricType int
@@ -181,30 +404,156 @@ func convertSLOMetricSource(typ sliMetricType) conversionrules.ConversionFunc {
 		if err != nil {
 			return "", err
 		}
-		var newPath string
-		switch typ {
-		case sliMetricTypeRaw:
-			newPath = "spec.objectives.#.rawMetric.query"
-		case sliMetricTypeTotal:
-			newPath = "spec.objectives.#.countMetrics.total"
-		case sliMetricTypeGood:
-			newPath = "spec.objectives.#.countMetrics.good"
-		case sliMetricTypeBad:
-			newPath = "spec.objectives.#.countMetrics.bad"
-		default:
-			return "", fmt.Errorf("unsupported metric source type %d", typ)
+		return setSLOMetricSpec(
+			jsonObject, path, typ, metricSource.Type, metricSource.MetricSourceRef, metricSource.Spec)
+	}
+}
+
+// convertV2alphaSLOMetricSpec converts v2alpha SLI metric to Nobl9 metric spec.
+// The metric type is taken from the resolved data source spec and its reference
+// is used as the metric source name.
+func convertV2alphaSLOMetricSpec(typ sliMetricType) conversionrules.ConversionFunc {
+	return func(jsonObject, path string, v any) (updatedJSON string, err error) {
+		metricSpec, err := anyToType[v2alpha.SLIMetricSpec](v)
+		if err != nil {
+			return "", err
+		}
+		if metricSpec.DataSourceSpec == nil {
+			return "", fmt.Errorf("%s.dataSourceSpec is required to determine the metric type", path)
 		}
-		newPath += "." + metricSource.Type
-		jsonObject, err = jsonpath.Set(jsonObject, newPath, metricSource.Spec)
+		return setSLOMetricSpec(
+			jsonObject, path, typ, metricSpec.DataSourceSpec.Type, metricSpec.DataSourceRef, metricSpec.Spec)
+	}
+}
+
+// setSLOMetricSpec sets the metric spec, translated to the Nobl9 metric spec of the metric type,
+// and the metric source name of the Nobl9 SLO.
+// If the spec was translated, its original shape is recorded as 'openslo.com/<path>.spec' annotation,
+// which is restored by the Nobl9 to OpenSLO converter.
+func setSLOMetricSpec(
+	jsonObject, path string,
+	typ sliMetricType,
+	metricType, name string,
+	spec map[string]any,
+) (string, error) {
+	var newPath string
+	switch typ {
+	case sliMetricTypeRaw:
+		newPath = "spec.objectives.#.rawMetric.query"
+	case sliMetricTypeTotal:
+		newPath = "spec.objectives.#.countMetrics.total"
+	case sliMetricTypeGood:
+		newPath = "spec.objectives.#.countMetrics.good"
+	case sliMetricTypeBad:
+		newPath = "spec.objectives.#.countMetrics.bad"
+	default:
+		return "", fmt.Errorf("unsupported metric source type %d", typ)
+	}
+	newPath += "." + metricType
+	translated, err := translateMetricSpec(metricType, spec)
+	if err != nil {
+		return "", err
+	}
+	jsonObject, err = jsonpath.Set(jsonObject, newPath, translated)
+	if err != nil {
+		return "", err
+	}
+	if !reflect.DeepEqual(spec, translated) {
+		jsonObject, err = annotations.AddOpenSLOToNobl9(jsonObject, path+".spec", spec)
 		if err != nil {
 			return "", err
 		}
-		jsonObject, err = jsonpath.Set(jsonObject, "spec.indicator.metricSource.name", metricSource.MetricSourceRef)
+	}
+	jsonObject, err = jsonpath.Set(jsonObject, "spec.indicator.metricSource.name", name)
+	if err != nil {
+		return "", err
+	}
+	return jsonObject, nil
+}
+
+// convertV1alphaSLOMetricSource converts v1alpha metric source to Nobl9 metric spec.
+// The source is used as the metric spec type and the query type as the field of that spec.
+// Since v1alpha SLO does not reference data sources, the source is also used as the metric source name.
+func convertV1alphaSLOMetricSource(jsonObject, path string, v any) (updatedJSON string, err error) {
+	metricSource, err := anyToType[v1alpha.SLOMetricSourceSpec](v)
+	if err != nil {
+		return "", err
+	}
+	var newPath string
+	switch {
+	case path == "spec.indicator.thresholdMetric":
+		newPath = "spec.objectives.#.rawMetric.query"
+	case strings.Contains(path, ".ratioMetrics."):
+		newPath = strings.Replace(path, ".ratioMetrics.", ".countMetrics.", 1)
+	default:
+		return "", fmt.Errorf("unsupported metric source path %s", path)
+	}
+	newPath += "." + metricSource.Source + "." + metricSource.QueryType
+	jsonObject, err = jsonpath.Set(jsonObject, newPath, metricSource.Query)
+	if err != nil {
+		return "", err
+	}
+	return sjson.Set(jsonObject, "spec.indicator.metricSource.name", metricSource.Source)
+}
+
+// convertV1alphaSLOTimeWindow converts v1alpha time window to Nobl9 time window.
+func convertV1alphaSLOTimeWindow(jsonObject, path string, v any) (updatedJSON string, err error) {
+	timeWindow, err := anyToType[v1alpha.SLOTimeWindow](v)
+	if err != nil {
+		return "", err
+	}
+	unit, count, err := v1alphaTimeWindowUnit(timeWindow.Unit, timeWindow.Count, timeWindow.IsRolling)
+	if err != nil {
+		return "", err
+	}
+	values := map[string]any{
+		"unit":      unit,
+		"count":     count,
+		"isRolling": timeWindow.IsRolling,
+	}
+	if timeWindow.Calendar != nil {
+		calendar, err := convertSLOCalendar(timeWindow.Calendar.StartTime, timeWindow.Calendar.TimeZone)
 		if err != nil {
 			return "", err
 		}
-		return jsonObject, nil
+		values["calendar"] = calendar
+	}
+	return sjson.Set(jsonObject, path, values)
+}
+
+// v1alphaTimeWindowUnit converts v1alpha time window unit and count to Nobl9 time window unit and count.
+// Nobl9 rolling time windows support minutes, hours and days, seconds are converted to minutes
+// and weeks to days, Nobl9 does not support second precision.
+// Calendar-aligned time windows support days, weeks, months and quarters.
+func v1alphaTimeWindowUnit(
+	unit v1alpha.SLOTimeWindowUnit,
+	count int,
+	isRolling bool,
+) (nobl9Unit string, nobl9Count int, err error) {
+	if isRolling {
+		switch unit {
+		case v1alpha.SLOTimeWindowUnitSecond:
+			return twindow.Minute.String(), count / 60, nil
+		case v1alpha.SLOTimeWindowUnitDay:
+			return twindow.Day.String(), count, nil
+		case v1alpha.SLOTimeWindowUnitWeek:
+			return twindow.Day.String(), count * 7, nil
+		}
+		return "", 0, fmt.Errorf("time window unit '%s' is not supported by Nobl9 rolling time window,"+
+			" must be one of: Second, Day, Week", unit)
 	}
+	switch unit {
+	case v1alpha.SLOTimeWindowUnitDay:
+		return twindow.Day.String(), count, nil
+	case v1alpha.SLOTimeWindowUnitWeek:
+		return twindow.Week.String(), count, nil
+	case v1alpha.SLOTimeWindowUnitMonth:
+		return twindow.Month.String(), count, nil
+	case v1alpha.SLOTimeWindowUnitQuarter:
+		return twindow.Quarter.String(), count, nil
+	}
+	return "", 0, fmt.Errorf("time window unit '%s' is not supported by Nobl9 calendar-aligned time window,"+
+		" must be one of: Day, Week, Month, Quarter", unit)
 }
 
 func convertDataSourceSpec(jsonObject, _ string, v any) (updatedJSON string, err error) {
@@ -215,16 +564,72 @@ func convertDataSourceSpec(jsonObject, _ string, v any) (updatedJSON string, err
 	return sjson.SetRaw(jsonObject, "spec."+spec.Type, string(spec.ConnectionDetails))
 }
 
-func convertConditionKind(jsonObject, path string, v any) (updatedJSON string, err error) {
-	kind, ok := v.(string)
+// parseSeverity matches OpenSLO severity with Nobl9 severity, ignoring case.
+func parseSeverity(severity string) (alertpolicy.Severity, bool) {
+	for _, s := range []alertpolicy.Severity{
+		alertpolicy.SeverityLow,
+		alertpolicy.SeverityMedium,
+		alertpolicy.SeverityHigh,
+	} {
+		if strings.EqualFold(severity, s.String()) {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func convertSeverity(jsonObject, path string, v any) (updatedJSON string, err error) {
+	value, ok := v.(string)
 	if !ok {
 		return "", fmt.Errorf("invalid type for %s, expected string, got %T", path, v)
 	}
-	if kind != "burnrate" {
-		return "", fmt.Errorf("unsupported condition kind '%s', only 'burnrate' is supported", kind)
+	severity, ok := parseSeverity(value)
+	if !ok {
+		return "", fmt.Errorf("unsupported severity '%s'", value)
+	}
+	return sjson.Set(jsonObject, "spec.severity", severity.String())
+}
+
+// alertPolicyCoolDownAnnotation defines Nobl9 AlertPolicy cool down,
+// it accepts both OpenSLO duration shorthand and Nobl9 duration.
+const alertPolicyCoolDownAnnotation = nobl9AnnotationPrefix + "spec.coolDown"
+
+// minimalCoolDown is the shortest AlertPolicy cool down accepted by Nobl9.
+const minimalCoolDown = 5 * time.Minute
+
+func convertAlertPolicyAnnotations(jsonObject, path string, v any) (updatedJSON string, err error) {
+	jsonObject, err = convertAnnotations(jsonObject, path, v)
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.(map[string]any)[alertPolicyCoolDownAnnotation]
+	if !ok {
+		return jsonObject, nil
+	}
+	coolDown, err := convertCoolDown(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid '%s' annotation: %w", alertPolicyCoolDownAnnotation, err)
+	}
+	return sjson.Set(jsonObject, "spec.coolDown", coolDown)
+}
+
+func convertCoolDown(v any) (string, error) {
+	value, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected string, got %T", v)
+	}
+	duration, err := parseDurationShorthand(value)
+	if err != nil {
+		// Nobl9 durations, like '1h30m', are not valid duration shorthands.
+		var parseErr error
+		if duration, parseErr = time.ParseDuration(value); parseErr != nil {
+			return "", err
+		}
+	}
+	if duration < minimalCoolDown {
+		return "", fmt.Errorf("cool down '%s' must be at least %s", value, formatDuration(minimalCoolDown))
 	}
-	newPath := strings.TrimSuffix(path, "spec.condition.kind") + "measurement"
-	return sjson.Set(jsonObject, newPath, alertpolicy.MeasurementAverageBurnRate.String())
+	return formatDuration(duration), nil
 }
 
 func convertNotificationTarget(jsonObject, path string, v any) (updatedJSON string, err error) {
@@ -3,14 +3,15 @@ package openslotonobl9
 import (
 	"bytes"
 	"cmp"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
-	"os"
 	"slices"
 	"strings"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
 	"github.com/nobl9/govy/pkg/govy"
 	"github.com/nobl9/nobl9-go/manifest"
@@ -26,35 +27,156 @@ const (
 	DomainOpenSLO = "openslo.com"
 )
 
+// Convert converts OpenSLO objects to Nobl9 objects using the default [Converter] options.
 func Convert(objects []openslo.Object) ([]manifest.Object, error) {
+	return ConvertWithOptions(objects)
+}
+
+// ConvertWithOptions converts OpenSLO objects to Nobl9 objects using a [Converter]
+// configured with the provided options.
+func ConvertWithOptions(objects []openslo.Object, options ...Option) ([]manifest.Object, error) {
+	return NewConverter(options...).Convert(objects)
+}
+
+// Convert converts OpenSLO objects to Nobl9 objects.
+// Use [Converter.ConvertWithReport] to also receive the conversion warnings.
+func (c *Converter) Convert(objects []openslo.Object) ([]manifest.Object, error) {
+	nobl9Objects, _, err := c.ConvertWithReport(objects)
+	return nobl9Objects, err
+}
+
+// ConvertWithReport converts OpenSLO objects to Nobl9 objects and returns
+// a [ConversionReport] which lists everything that was skipped, dropped, or defaulted.
+func (c *Converter) ConvertWithReport(objects []openslo.Object) ([]manifest.Object, ConversionReport, error) {
+	report := ConversionReport{}
+	nobl9Objects, err := c.convert(objects, &report)
+	if err != nil {
+		return nil, report, err
+	}
+	return nobl9Objects, report, nil
+}
+
+func (c *Converter) convert(objects []openslo.Object, report *ConversionReport) ([]manifest.Object, error) {
 	if len(objects) == 0 {
 		return nil, errors.New("no OpenSLO objects provided")
 	}
+	// Validate before resolving references, resolved v2alpha metrics define both
+	// dataSourceRef and dataSourceSpec which is forbidden by the OpenSLO SDK.
+	if err := openslosdk.Validate(objects...); err != nil {
+		return nil, fmt.Errorf("failed to validate OpenSLO objects: %w", err)
+	}
 	objects, err := resolveObjectReferences(objects)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve OpenSLO object references: %w", err)
 	}
-	if err = openslosdk.Validate(objects...); err != nil {
-		return nil, fmt.Errorf("failed to validate OpenSLO objects: %w", err)
+	objects = c.normalizeMetricTypes(objects)
+	projects, err := c.resolveProjects(objects)
+	if err != nil {
+		return nil, err
+	}
+	if objects, err = c.extractInlineDataSources(objects, projects); err != nil {
+		return nil, err
 	}
 
+	var (
+		validationErrs govy.ValidatorErrors
+		conversionErrs ObjectConversionErrors
+	)
+	dataSources := c.indexDataSources(objects, projects)
 	nobl9JSONObjects := make([]string, 0, len(objects))
 	for _, object := range objects {
-		jsonObject, err := opensloObjectToNobl9(object)
+		jsonObjects, err := c.convertObject(object, projects.get(object), dataSources, report)
 		if err != nil {
 			// Do not wrap validation errors, they already have all the details to identify the faulty object.
-			switch err.(type) {
-			case *govy.ValidatorError, govy.ValidatorErrors:
-				return nil, err
+			var conversionErr *ObjectConversionError
+			switch v := err.(type) {
+			case *govy.ValidatorError:
+				validationErrs = append(validationErrs, v)
+			case govy.ValidatorErrors:
+				validationErrs = append(validationErrs, v...)
+			default:
+				if !errors.As(err, &conversionErr) {
+					conversionErr = newObjectConversionError(object, "", err)
+				}
+				conversionErrs = append(conversionErrs, conversionErr)
 			}
+			continue
 		}
-		nobl9JSONObjects = append(nobl9JSONObjects, jsonObject)
+		nobl9JSONObjects = append(nobl9JSONObjects, jsonObjects...)
+	}
+	if err = joinConversionErrors(validationErrs, conversionErrs); err != nil {
+		return nil, err
+	}
+	nobl9Objects, err := sdk.DecodeObjects([]byte("[" + strings.Join(nobl9JSONObjects, ",") + "]"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Nobl9 objects: %w", err)
 	}
-	return sdk.DecodeObjects([]byte("[" + strings.Join(nobl9JSONObjects, ",") + "]"))
+	if !c.generateProjects {
+		return nobl9Objects, nil
+	}
+	projectObjects, err := c.newProjects(objects, projects, nobl9Objects)
+	if err != nil {
+		return nil, err
+	}
+	return append(projectObjects, nobl9Objects...), nil
 }
 
-func opensloObjectToNobl9(opensloObject openslo.Object) (nobl9Object string, err error) {
-	if err = opensloObjectValidation.Validate(opensloObject); err != nil {
+// convertObject converts a single OpenSLO object into one or more Nobl9 objects.
+// Skipped objects are not converted and no objects are returned for them.
+func (c *Converter) convertObject(
+	object openslo.Object,
+	project string,
+	dataSources map[string]dataSourceIdentity,
+	report *ConversionReport,
+) ([]string, error) {
+	if slo, ok := object.(v2alpha.SLO); ok && slo.Spec.HasCompositeObjectives() {
+		return c.v2alphaCompositeSLOToNobl9(slo, project, dataSources, report)
+	}
+	object, err := c.resolveMetricSource(object, dataSources, report)
+	if err != nil {
+		return nil, err
+	}
+	jsonObject, err := c.opensloObjectToNobl9(object, project, report)
+	if err != nil || jsonObject == "" {
+		return nil, err
+	}
+	if object.GetKind() == openslo.KindAlertNotificationTarget {
+		if err = validateAlertMethod(object, jsonObject); err != nil {
+			return nil, err
+		}
+	}
+	return []string{jsonObject}, nil
+}
+
+// joinConversionErrors combines errors of all the objects which failed to convert.
+// A single validation error is returned as is, so that its type is preserved.
+func joinConversionErrors(validationErrs govy.ValidatorErrors, conversionErrs ObjectConversionErrors) error {
+	var errs []error
+	switch len(validationErrs) {
+	case 0:
+	case 1:
+		errs = append(errs, validationErrs[0])
+	default:
+		errs = append(errs, validationErrs)
+	}
+	if len(conversionErrs) > 0 {
+		errs = append(errs, conversionErrs)
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// opensloObjectToNobl9 converts the OpenSLO object to a Nobl9 JSON object.
+// The project is assigned to the object if it was not set by the conversion,
+// if it's empty, the object is assigned the default project.
+func (c *Converter) opensloObjectToNobl9(
+	opensloObject openslo.Object,
+	project string,
+	report *ConversionReport,
+) (nobl9Object string, err error) {
+	if err = validateOpenSLOObject(opensloObject); err != nil {
 		return "", err
 	}
 
@@ -76,29 +198,165 @@ func opensloObjectToNobl9(opensloObject openslo.Object) (nobl9Object string, err
 	if err != nil {
 		return "", err
 	}
-	rules, err := getConversionRules(opensloVersion, opensloKind)
+	rules, err := c.getConversionRules(opensloVersion, opensloKind)
 	if err != nil {
+		if errors.Is(err, errUnsupportedKind) && c.unsupportedKind == UnsupportedKindSkip {
+			return "", c.warn(report, newWarning(opensloObject, "", WarningCodeSkippedKind, err.Error()+", object skipped"))
+		}
 		return "", err
 	}
 	if len(rules) == 0 {
-		fmt.Fprintf(os.Stderr, "no conversion rules for %s %s, skipping\n", opensloVersion, opensloKind)
-		return "", nil
+		return "", c.handleStandaloneObject(opensloObject, opensloVersion, opensloKind, report)
 	}
 
 	nobl9Object = "{}"
+	// Nobl9 paths mapped to the OpenSLO paths they were converted from.
+	origins := make(map[string]string)
 	for _, path := range paths {
-		nobl9Object, err = rules.Convert(nobl9Object, path.Path, path.Value)
+		if !rules.Covers(path.Path) {
+			// OpenSLO-only fields are preserved as annotations,
+			// they are applied back to the OpenSLO object by the Nobl9 to OpenSLO converter.
+			if !isPreservedValue(path) {
+				continue
+			}
+			if path.Value == nil {
+				if err = c.warn(report, newWarning(opensloObject, path.Path, WarningCodeDroppedField,
+					"Nobl9 annotations cannot hold null values, the value was dropped")); err != nil {
+					return "", err
+				}
+				continue
+			}
+			nobl9Object, err = annotations.AddOpenSLOToNobl9(nobl9Object, path.Path, path.Value)
+			if err != nil {
+				return "", newObjectConversionError(opensloObject, path.Path, err)
+			}
+			continue
+		}
+		updatedObject, err := rules.Convert(nobl9Object, path.Path, path.Value)
 		if err != nil {
+			return "", newObjectConversionError(opensloObject, path.Path, err)
+		}
+		for _, nobl9Path := range changedSpecPaths(nobl9Object, updatedObject) {
+			origins[nobl9Path] = path.Path
+		}
+		nobl9Object = updatedObject
+	}
+	for _, nobl9Path := range findDroppedPaths(nobl9Object, origins) {
+		if err = c.warn(report, newWarning(opensloObject, origins[nobl9Path], WarningCodeDroppedField,
+			fmt.Sprintf("Nobl9 does not support '%s', the value was dropped", nobl9Path))); err != nil {
 			return "", err
 		}
 	}
-	nobl9Object, err = setDefaults(nobl9Object)
+	if !gjson.Get(nobl9Object, "metadata.project").Exists() {
+		if project == "" {
+			if err = c.warn(report, newWarning(opensloObject, "metadata.annotations."+projectAnnotation,
+				WarningCodeDefaultedValue, fmt.Sprintf("project was not provided, defaulted to '%s'", c.defaultProject)),
+			); err != nil {
+				return "", err
+			}
+		}
+		// The project was not defined by the annotation, mark it so that converting
+		// the object back to OpenSLO does not add the annotation.
+		if cmp.Or(project, c.defaultProject) != defaultProject {
+			if nobl9Object, err = annotations.AddImplicitProject(nobl9Object); err != nil {
+				return "", err
+			}
+		}
+	}
+	nobl9Object, err = setDefaults(nobl9Object, cmp.Or(project, c.defaultProject))
 	if err != nil {
 		return "", err
 	}
 	return annotations.AddOpenSLOToNobl9(nobl9Object, "apiVersion", opensloVersion)
 }
 
+// handleStandaloneObject reports objects which have no conversion rules,
+// these are only converted as a part of the objects which reference them.
+func (c *Converter) handleStandaloneObject(
+	object openslo.Object,
+	version openslo.Version,
+	kind openslo.Kind,
+	report *ConversionReport,
+) error {
+	var msg string
+	switch kind {
+	case openslo.KindSLI:
+		msg = fmt.Sprintf("%s %s is not referenced by any SLO", version, kind)
+	case openslo.KindAlertCondition:
+		msg = fmt.Sprintf("%s %s is not referenced by any AlertPolicy", version, kind)
+	default:
+		msg = fmt.Sprintf("no conversion rules for %s %s", version, kind)
+	}
+	if c.standaloneObject == StandaloneObjectFail {
+		return newObjectConversionError(object, "", fmt.Errorf("%w: %s", errStandaloneObject, msg))
+	}
+	return c.warn(report, newWarning(object, "", WarningCodeSkippedKind, msg+", object skipped"))
+}
+
+// changedSpecPaths returns 'spec' leaf paths which differ between the two JSON objects.
+func changedSpecPaths(before, after string) []string {
+	walker := jsonpath.NewWalker()
+	walker.Walk(gjson.Get(after, "spec"), "spec")
+	var changed []string
+	for path, value := range walker.Paths() {
+		if !isLeafValue(value) {
+			continue
+		}
+		if gjson.Get(before, path).Raw != gjson.Get(after, path).Raw {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+// findDroppedPaths returns the sorted paths which are not recognized by the Nobl9 object schema.
+// If the object cannot be decoded, no paths are returned, decoding error is reported later.
+func findDroppedPaths(jsonObject string, paths map[string]string) []string {
+	objects, err := sdk.DecodeObjects([]byte(jsonObject))
+	if err != nil || len(objects) != 1 {
+		return nil
+	}
+	data, err := json.Marshal(objects[0])
+	if err != nil {
+		return nil
+	}
+	var dropped []string
+	for path := range paths {
+		if !gjson.GetBytes(data, path).Exists() {
+			dropped = append(dropped, path)
+		}
+	}
+	slices.Sort(dropped)
+	return dropped
+}
+
+// isPreservedValue returns true if the value of OpenSLO-only field is preserved as a whole.
+// Objects and arrays are preserved through their elements, unless they are empty.
+// Empty top-level objects, like 'spec', are not preserved, every Nobl9 object defines them.
+func isPreservedValue(path pathTuple) bool {
+	switch v := path.Value.(type) {
+	case map[string]any:
+		return len(v) == 0 && strings.Contains(path.Path, ".")
+	case []any:
+		return len(v) == 0
+	default:
+		return true
+	}
+}
+
+func isLeafValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case map[string]any, []any:
+		return false
+	default:
+		return true
+	}
+}
+
 type pathTuple struct {
 	Path  string
 	Value any
@@ -110,6 +368,8 @@ func sortPaths(pathsMap map[string]any) []pathTuple {
 		"metadata.annotations":                strings.HasPrefix,
 		"spec.indicator.spec.ratioMetric":     strings.HasPrefix,
 		"spec.indicator.spec.thresholdMetric": strings.HasPrefix,
+		"spec.indicator.thresholdMetric":      strings.HasPrefix,
+		"spec.sli":                            strings.HasPrefix,
 	}
 
 	keys := slices.SortedFunc(maps.Keys(pathsMap), func(s1, s2 string) int {
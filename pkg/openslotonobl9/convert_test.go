@@ -3,21 +3,30 @@ package openslotonobl9
 import (
 	"bytes"
 	"encoding/json"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
 	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
 	"github.com/goccy/go-yaml"
 	"github.com/nobl9/govy/pkg/govytest"
 	"github.com/nobl9/govy/pkg/rules"
 	"github.com/nobl9/nobl9-go/manifest"
+	nobl9v1alpha "github.com/nobl9/nobl9-go/manifest/v1alpha"
+	v1alphaService "github.com/nobl9/nobl9-go/manifest/v1alpha/service"
+	v1alphaSLO "github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
 	"github.com/nobl9/nobl9-go/sdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/sjson"
+
+	"github.com/nobl9/nobl9-openslo/pkg/conversionrules"
 )
 
 const (
@@ -25,6 +34,31 @@ const (
 	outputsDir = "./test_data/outputs/"
 )
 
+// convertOptions are the options used to convert the input files which depend on them.
+var convertOptions = map[string][]Option{
+	"v1_project_generation.yaml": {
+		WithProjectGeneration(map[string]ProjectDetails{
+			"default": {Description: "Default project"},
+			"team-a":  {DisplayName: "Team A (production)"},
+		}),
+	},
+	"v1_metric_type_user_aliases.yaml": {
+		WithMetricTypeAliases(map[string]string{
+			"my-prometheus": "prometheus",
+			"stackdriver":   "prometheus",
+		}),
+	},
+	"v1_project_resolver_label.yaml": {
+		WithProjectResolvers(ProjectFromLabel("team")),
+	},
+	"v1_project_resolver_service.yaml": {
+		WithProjectResolvers(ProjectFromService(), ProjectFromLabel("team")),
+	},
+	"v1_project_resolver_static.yaml": {
+		WithProjectResolvers(ProjectFromService(), StaticProject("platform")),
+	},
+}
+
 func TestConvert(t *testing.T) {
 	inputs := listAllFilesInDir(t, inputsDir)
 	outputs := listAllFilesInDir(t, outputsDir)
@@ -45,7 +79,7 @@ func TestConvert(t *testing.T) {
 			opensloObjects, err := openslosdk.Decode(bytes.NewReader(inputFileData), openslosdk.FormatYAML)
 			require.NoError(t, err)
 
-			actual, err := Convert(opensloObjects)
+			actual, err := ConvertWithOptions(opensloObjects, convertOptions[fileName]...)
 			require.NoError(t, err)
 			var buf bytes.Buffer
 			err = sdk.EncodeObjects(actual, &buf, manifest.ObjectFormatJSON)
@@ -69,18 +103,6 @@ func TestConvert_Validate(t *testing.T) {
 		objects []openslo.Object
 		errors  []govytest.ExpectedRuleError
 	}{
-		"invalid version": {
-			objects: []openslo.Object{v1alpha.NewService(
-				v1alpha.Metadata{Name: "test"},
-				v1alpha.ServiceSpec{},
-			)},
-			errors: []govytest.ExpectedRuleError{
-				{
-					PropertyPath: "apiVersion",
-					Code:         rules.ErrorCodeOneOf,
-				},
-			},
-		},
 		"invalid type for v1.AlertNotificationTarget": {
 			objects: []openslo.Object{v1.NewAlertNotificationTarget(
 				v1.Metadata{Name: "test"},
@@ -104,6 +126,7 @@ func TestConvert_Validate(t *testing.T) {
 						DomainNobl9 + "/spec.jira.url":        "https://jira.example.com",
 						DomainNobl9 + "/spec.jira.username":   "my-user",
 						DomainNobl9 + "/spec.jira.projectKey": "secret",
+						DomainNobl9 + "/spec.jira.apiToken":   "token",
 					},
 				},
 				v1.AlertNotificationTargetSpec{
@@ -186,6 +209,40 @@ func TestConvert_Validate(t *testing.T) {
 				},
 			)},
 		},
+		"missing required connection details for v1.DataSource": {
+			objects: []openslo.Object{v1.NewDataSource(
+				v1.Metadata{Name: "test"},
+				v1.DataSourceSpec{
+					Type:              "prometheus",
+					ConnectionDetails: json.RawMessage(`{}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.connectionDetails.url",
+					Code:         rules.ErrorCodeRequired,
+				},
+			},
+		},
+		"unknown connection details field for v1.DataSource (Direct via annotation)": {
+			objects: []openslo.Object{v1.NewDataSource(
+				v1.Metadata{
+					Name:        "test",
+					Annotations: v1.Annotations{DomainNobl9 + "/kind": "Direct"},
+				},
+				v1.DataSourceSpec{
+					Type:              "datadog",
+					ConnectionDetails: json.RawMessage(`{"site": "eu", "url": "https://example.com"}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.connectionDetails.url",
+					Code:         errCodeUnknownField,
+					Message:      "field is not defined by Nobl9 'datadog' Direct config",
+				},
+			},
+		},
 		"forbidden kind annotation": {
 			objects: []openslo.Object{v1.NewService(
 				v1.Metadata{
@@ -232,7 +289,7 @@ func TestConvert_Validate(t *testing.T) {
 								Counter: true,
 								Good: &v1.SLIMetricSpec{
 									MetricSource: v1.SLIMetricSource{
-										Type: "Prometheus",
+										Type: "Prometeus",
 										Spec: map[string]any{
 											"query": `sum(http_requests{k8s_cluster="prod",component="web",code=~"2xx|4xx"})`,
 										},
@@ -240,7 +297,7 @@ func TestConvert_Validate(t *testing.T) {
 								},
 								Total: &v1.SLIMetricSpec{
 									MetricSource: v1.SLIMetricSource{
-										Type: "Prometheus",
+										Type: "Prometeus",
 										Spec: map[string]any{
 											"query": `sum(http_requests{k8s_cluster="prod",component="web"})`,
 										},
@@ -346,6 +403,436 @@ func TestConvert_Validate(t *testing.T) {
 				},
 			)},
 		},
+		"invalid source and query type for v1alpha.SLO": {
+			objects: []openslo.Object{v1alpha.NewSLO(
+				v1alpha.Metadata{Name: "test"},
+				v1alpha.SLOSpec{
+					Service: "web",
+					TimeWindows: []v1alpha.SLOTimeWindow{
+						{
+							Unit:      v1alpha.SLOTimeWindowUnitDay,
+							Count:     7,
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v1alpha.SLOBudgetingMethodOccurrences,
+					Objectives: []v1alpha.SLOObjective{
+						{
+							DisplayName:  "Good",
+							Value:        ptr(1.0),
+							BudgetTarget: ptr(0.995),
+							RatioMetrics: &v1alpha.SLORatioMetrics{
+								Good: v1alpha.SLOMetricSourceSpec{
+									Source:    "Prometeus",
+									QueryType: "query",
+									Query:     `sum(http_requests{code=~"2xx|4xx"})`,
+								},
+								Total: v1alpha.SLOMetricSourceSpec{
+									Source:    "prometheus",
+									QueryType: "query",
+									Query:     `sum(http_requests)`,
+								},
+							},
+						},
+					},
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec",
+					Message:      "all metrics must define the same 'source', Nobl9 SLO supports only a single metric source",
+				},
+				{
+					PropertyPath:    "spec.objectives[0].ratioMetrics.good.source",
+					Code:            rules.ErrorCodeOneOf,
+					ContainsMessage: "must be one of: prometheus, datadog",
+				},
+				{
+					PropertyPath: "spec.objectives[0].ratioMetrics.total.queryType",
+					Code:         rules.ErrorCodeOneOf,
+					Message:      "must be one of: promql",
+				},
+			},
+		},
+		"time window in seconds for v1alpha.SLO": {
+			objects: []openslo.Object{v1alpha.NewSLO(
+				v1alpha.Metadata{Name: "test"},
+				v1alpha.SLOSpec{
+					Service: "web",
+					Indicator: &v1alpha.SLOIndicator{
+						ThresholdMetric: v1alpha.SLOMetricSourceSpec{
+							Source:    "prometheus",
+							QueryType: "promql",
+							Query:     `api_server_requestMsec{job="nginx"}`,
+						},
+					},
+					TimeWindows: []v1alpha.SLOTimeWindow{
+						{
+							Unit:      v1alpha.SLOTimeWindowUnitSecond,
+							Count:     90,
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v1alpha.SLOBudgetingMethodOccurrences,
+					Objectives: []v1alpha.SLOObjective{
+						{
+							DisplayName:  "Good",
+							Value:        ptr(200.0),
+							BudgetTarget: ptr(0.995),
+							Operator:     v1alpha.OperatorLT,
+						},
+					},
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.timeWindows[0].count",
+					Message:      "Nobl9 does not support second precision, time window must be a whole number of minutes",
+				},
+			},
+		},
+		"unsupported budgeting method and raw ratio metric for v2alpha.SLO": {
+			objects: []openslo.Object{v2alpha.NewSLO(
+				v2alpha.Metadata{Name: "test"},
+				v2alpha.SLOSpec{
+					ServiceRef: "web",
+					SLI: &v2alpha.SLOSLIInline{
+						Metadata: v2alpha.Metadata{Name: "web-errors"},
+						Spec: v2alpha.SLISpec{
+							RatioMetric: &v2alpha.SLIRatioMetric{
+								RawType: v2alpha.SLIRawMetricTypeFailure,
+								Raw: &v2alpha.SLIMetricSpec{
+									DataSourceRef: "prometheus",
+									Spec:          map[string]any{"promql": `sum(http_requests{code="5xx"})`},
+								},
+							},
+						},
+					},
+					TimeWindow: []v2alpha.SLOTimeWindow{
+						{
+							Duration:  v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitDay),
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v2alpha.SLOBudgetingMethodRatioTimeslices,
+					Objectives: []v2alpha.SLOObjective{
+						{
+							DisplayName:     "Good",
+							Target:          ptr(0.995),
+							TimeSliceWindow: ptr(v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitMinute)),
+						},
+					},
+				},
+			), v2alpha.NewDataSource(
+				v2alpha.Metadata{Name: "prometheus"},
+				v2alpha.DataSourceSpec{
+					Type:              "prometheus",
+					ConnectionDetails: json.RawMessage(`{"url": "https://example.com"}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.budgetingMethod",
+					Code:         rules.ErrorCodeOneOf,
+					Message:      "must be one of: Occurrences, Timeslices",
+				},
+				{
+					PropertyPath:    "spec.sli.spec.ratioMetric.raw",
+					Code:            rules.ErrorCodeForbidden,
+					ContainsMessage: "Nobl9 does not support raw ratio metrics",
+				},
+			},
+		},
+		"missing dataSourceRef for composite v2alpha.SLO": {
+			objects: []openslo.Object{v2alpha.NewSLO(
+				v2alpha.Metadata{Name: "test"},
+				v2alpha.SLOSpec{
+					ServiceRef: "web",
+					TimeWindow: []v2alpha.SLOTimeWindow{
+						{
+							Duration:  v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitDay),
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v2alpha.SLOBudgetingMethodOccurrences,
+					Objectives: []v2alpha.SLOObjective{
+						{
+							DisplayName: "Latency",
+							Target:      ptr(0.995),
+							Value:       ptr(200.0),
+							Operator:    v2alpha.OperatorLT,
+							SLI: &v2alpha.SLOSLIInline{
+								Metadata: v2alpha.Metadata{Name: "web-latency"},
+								Spec: v2alpha.SLISpec{
+									ThresholdMetric: &v2alpha.SLIMetricSpec{
+										DataSourceSpec: &v2alpha.DataSourceSpec{
+											Type:              "Prometeus",
+											ConnectionDetails: json.RawMessage(`{"url": "https://example.com"}`),
+										},
+										Spec: map[string]any{"promql": `api_server_requestMsec{job="nginx"}`},
+									},
+								},
+							},
+						},
+					},
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.objectives[0].sli.spec.thresholdMetric.dataSourceRef",
+					Code:         rules.ErrorCodeRequired,
+				},
+				{
+					PropertyPath:    "spec.objectives[0].sli.spec.thresholdMetric.dataSourceSpec.type",
+					Code:            rules.ErrorCodeOneOf,
+					ContainsMessage: "must be one of: prometheus, datadog",
+				},
+			},
+		},
+		"invalid metric spec for v1.SLO": {
+			objects: []openslo.Object{v1.NewSLO(
+				v1.Metadata{Name: "test"},
+				v1.SLOSpec{
+					Service: "web",
+					Indicator: &v1.SLOIndicatorInline{
+						Metadata: v1.Metadata{Name: "web-successful-requests-ratio"},
+						Spec: v1.SLISpec{
+							RatioMetric: &v1.SLIRatioMetric{
+								Counter: true,
+								Good: &v1.SLIMetricSpec{
+									MetricSource: v1.SLIMetricSource{
+										Type:            "prometheus",
+										MetricSourceRef: "foo",
+										Spec:            map[string]any{"promql": ""},
+									},
+								},
+								Total: &v1.SLIMetricSpec{
+									MetricSource: v1.SLIMetricSource{
+										Type:            "prometheus",
+										MetricSourceRef: "foo",
+										Spec: map[string]any{
+											"query": `sum(http_requests{k8s_cluster="prod",component="web"})`,
+										},
+									},
+								},
+							},
+						},
+					},
+					TimeWindow: []v1.SLOTimeWindow{
+						{
+							Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+					Objectives:      []v1.SLOObjective{{DisplayName: "Good", Target: ptr(0.995)}},
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.indicator.spec.ratioMetric.good.metricSource.spec.promql",
+					Code:         rules.ErrorCodeStringNotEmpty,
+				},
+			},
+		},
+		"undecodable metric spec for v1.SLO": {
+			objects: []openslo.Object{v1.NewSLO(
+				v1.Metadata{Name: "test"},
+				v1.SLOSpec{
+					Service: "web",
+					Indicator: &v1.SLOIndicatorInline{
+						Metadata: v1.Metadata{Name: "web-latency"},
+						Spec: v1.SLISpec{
+							ThresholdMetric: &v1.SLIMetricSpec{
+								MetricSource: v1.SLIMetricSource{
+									Type:            "prometheus",
+									MetricSourceRef: "foo",
+									Spec:            map[string]any{"promql": 200},
+								},
+							},
+						},
+					},
+					TimeWindow: []v1.SLOTimeWindow{
+						{
+							Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+					Objectives:      []v1.SLOObjective{{Target: ptr(0.995), Value: ptr(200.0), Operator: v1.OperatorLT}},
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.indicator.spec.thresholdMetric.metricSource.spec.promql",
+					Message:      "number cannot be decoded into string",
+				},
+			},
+		},
+		"invalid metric spec for v2alpha.SLO": {
+			objects: []openslo.Object{v2alpha.NewSLO(
+				v2alpha.Metadata{Name: "test"},
+				v2alpha.SLOSpec{
+					ServiceRef: "web",
+					SLI: &v2alpha.SLOSLIInline{
+						Metadata: v2alpha.Metadata{Name: "web-latency"},
+						Spec: v2alpha.SLISpec{
+							ThresholdMetric: &v2alpha.SLIMetricSpec{
+								DataSourceRef: "prometheus",
+								Spec:          map[string]any{"promql": `api_server_requestMsec{job="nginx"}`, "step": "1m"},
+							},
+						},
+					},
+					TimeWindow: []v2alpha.SLOTimeWindow{
+						{
+							Duration:  v2alpha.NewDurationShorthand(1, v2alpha.DurationShorthandUnitDay),
+							IsRolling: true,
+						},
+					},
+					BudgetingMethod: v2alpha.SLOBudgetingMethodOccurrences,
+					Objectives: []v2alpha.SLOObjective{
+						{DisplayName: "Good", Target: ptr(0.995), Value: ptr(200.0), Operator: v2alpha.OperatorLT},
+					},
+				},
+			), v2alpha.NewDataSource(
+				v2alpha.Metadata{Name: "prometheus"},
+				v2alpha.DataSourceSpec{
+					Type:              "prometheus",
+					ConnectionDetails: json.RawMessage(`{"url": "https://example.com"}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.sli.spec.thresholdMetric.spec.step",
+					Code:         errCodeUnknownField,
+					Message:      "field is not defined by Nobl9 'prometheus' metric spec",
+				},
+			},
+		},
+		"invalid type for v2alpha.DataSource (Direct via annotation)": {
+			objects: []openslo.Object{v2alpha.NewDataSource(
+				v2alpha.Metadata{
+					Name:        "test",
+					Annotations: v2alpha.Annotations{DomainNobl9 + "/kind": "Direct"},
+				},
+				v2alpha.DataSourceSpec{
+					Type:              "prometheus",
+					ConnectionDetails: json.RawMessage(`{"url": "https://example.com"}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath:    "spec.type",
+					Code:            rules.ErrorCodeOneOf,
+					ContainsMessage: "must be one of: datadog, newRelic",
+				},
+			},
+		},
+		"undecodable connection details for v2alpha.DataSource": {
+			objects: []openslo.Object{v2alpha.NewDataSource(
+				v2alpha.Metadata{Name: "test"},
+				v2alpha.DataSourceSpec{
+					Type:              "prometheus",
+					ConnectionDetails: json.RawMessage(`{"url": 8080}`),
+				},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.connectionDetails.url",
+					Message:      "number cannot be decoded into string",
+				},
+			},
+		},
+		"forbidden kind annotation for v2alpha.Service": {
+			objects: []openslo.Object{v2alpha.NewService(
+				v2alpha.Metadata{
+					Name:        "test",
+					Annotations: v2alpha.Annotations{DomainNobl9 + "/kind": "MyType"},
+				},
+				v2alpha.ServiceSpec{},
+			)},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "metadata.annotations['nobl9.com/kind']",
+					IsKeyError:   true,
+					Code:         rules.ErrorCodeNotOneOf,
+				},
+			},
+		},
+		"unknown AlertPolicy condition severity": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				policy.Spec.Conditions[0].AlertPolicyConditionInline.Spec.Severity = "critical"
+			})},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.conditions[0].spec.severity",
+					Code:         rules.ErrorCodeOneOf,
+					Message:      "must be one of (case-insensitive): Low, Medium, High",
+				},
+			},
+		},
+		"abbreviated AlertPolicy condition severity": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				policy.Spec.Conditions[0].AlertPolicyConditionInline.Spec.Severity = "med"
+			})},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.conditions[0].spec.severity",
+					Code:         rules.ErrorCodeOneOf,
+					Message:      "must be one of (case-insensitive): Low, Medium, High",
+				},
+			},
+		},
+		"metric spec field already set with its renamed counterpart": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.Indicator.Spec.ThresholdMetric.MetricSource = v1.SLIMetricSource{
+					MetricSourceRef: "my-source",
+					Type:            "prometheus",
+					Spec:            map[string]any{"query": "up", "promql": "up"},
+				}
+			})},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.indicator.spec.thresholdMetric.metricSource.spec",
+					Message:      "failed to translate prometheus metric spec: query cannot be set along with 'promql'",
+				},
+			},
+		},
+		"metric spec field already set with its flattened counterpart": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.Indicator.Spec.ThresholdMetric.MetricSource = v1.SLIMetricSource{
+					MetricSourceRef: "my-source",
+					Type:            "cloudWatch",
+					Spec: map[string]any{
+						"region":     "eu-central-1",
+						"metricName": "Errors",
+						"metricStat": map[string]any{"metric": map[string]any{"metricName": "Errors"}},
+					},
+				}
+			})},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.indicator.spec.thresholdMetric.metricSource.spec",
+					Message: "failed to translate cloudWatch metric spec:" +
+						" metricStat.metric.metricName cannot be set along with 'metricName'",
+				},
+			},
+		},
+		"invalid metric spec block": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.Indicator.Spec.ThresholdMetric.MetricSource = v1.SLIMetricSource{
+					MetricSourceRef: "my-source",
+					Type:            "cloudWatch",
+					Spec:            map[string]any{"region": "eu-central-1", "metricStat": "Errors"},
+				}
+			})},
+			errors: []govytest.ExpectedRuleError{
+				{
+					PropertyPath: "spec.indicator.spec.thresholdMetric.metricSource.spec",
+					Message:      "failed to translate cloudWatch metric spec: metricStat must be an object, got string",
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -363,6 +850,639 @@ func TestConvert_Validate(t *testing.T) {
 	}
 }
 
+// Validation errors should be covered with [TestConvert_Validate].
+func TestConvert_ConversionErrors(t *testing.T) {
+	tests := map[string]struct {
+		objects []openslo.Object
+		options []Option
+		err     string
+	}{
+		"rolling months for v1.SLO": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.TimeWindow = []v1.SLOTimeWindow{{
+					Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitMonth),
+					IsRolling: true,
+				}}
+			})},
+			err: "failed to convert SLO web-latency at spec.timeWindow.0: duration unit 'M' is not supported" +
+				" by Nobl9 rolling time window, must be one of: m, h, d, w",
+		},
+		"calendar hours for v1.SLO": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.TimeWindow = []v1.SLOTimeWindow{{
+					Duration: v1.NewDurationShorthand(12, v1.DurationShorthandUnitHour),
+					Calendar: &v1.SLOCalendar{StartTime: "2022-01-01 12:00:00", TimeZone: "Europe/Warsaw"},
+				}}
+			})},
+			err: "failed to convert SLO web-latency at spec.timeWindow.0: duration unit 'h' is not supported" +
+				" by Nobl9 calendar-aligned time window, must be one of: d, w, M, Q, Y",
+		},
+		"multiple time windows for v1.SLO": {
+			objects: []openslo.Object{newTestSLO(func(slo *v1.SLO) {
+				slo.Spec.TimeWindow = []v1.SLOTimeWindow{
+					{Duration: v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay), IsRolling: true},
+					{Duration: v1.NewDurationShorthand(1, v1.DurationShorthandUnitWeek), IsRolling: true},
+				}
+			})},
+			err: "failed to validate OpenSLO objects:" +
+				" Validation for v1.SLO 'web-latency' at index 0 has failed for the following properties:\n" +
+				"  - 'spec.timeWindow' with value '[{\"duration\":\"1d\",\"isRolling\":true}," +
+				"{\"duration\":\"1w\",\"isRolling\":true}]':\n" +
+				"    - length must be between 1 and 1",
+		},
+		"rolling months for v1alpha.SLO": {
+			objects: []openslo.Object{newTestV1alphaSLO(func(slo *v1alpha.SLO) {
+				slo.Spec.TimeWindows = []v1alpha.SLOTimeWindow{{
+					Unit:      v1alpha.SLOTimeWindowUnitMonth,
+					Count:     1,
+					IsRolling: true,
+				}}
+			})},
+			err: "failed to convert SLO web-latency at spec.timeWindows.0: time window unit 'Month' is not supported" +
+				" by Nobl9 rolling time window, must be one of: Second, Day, Week",
+		},
+		"rolling quarters for v1alpha.SLO": {
+			objects: []openslo.Object{newTestV1alphaSLO(func(slo *v1alpha.SLO) {
+				slo.Spec.TimeWindows = []v1alpha.SLOTimeWindow{{
+					Unit:      v1alpha.SLOTimeWindowUnitQuarter,
+					Count:     1,
+					IsRolling: true,
+				}}
+			})},
+			err: "failed to convert SLO web-latency at spec.timeWindows.0: time window unit 'Quarter' is not supported" +
+				" by Nobl9 rolling time window, must be one of: Second, Day, Week",
+		},
+		"calendar seconds for v1alpha.SLO": {
+			objects: []openslo.Object{newTestV1alphaSLO(func(slo *v1alpha.SLO) {
+				slo.Spec.TimeWindows = []v1alpha.SLOTimeWindow{{
+					Unit:     v1alpha.SLOTimeWindowUnitSecond,
+					Count:    3600,
+					Calendar: &v1alpha.SLOCalendar{StartTime: "2022-01-01 12:00:00", TimeZone: "Europe/Warsaw"},
+				}}
+			})},
+			err: "failed to convert SLO web-latency at spec.timeWindows.0: time window unit 'Second' is not supported" +
+				" by Nobl9 calendar-aligned time window, must be one of: Day, Week, Month, Quarter",
+		},
+		"unsupported AlertPolicy condition measurement": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				condition := policy.Spec.Conditions[0].AlertPolicyConditionInline
+				condition.Metadata.Annotations = v1.Annotations{DomainNobl9 + "/measurement": "budgetDrop"}
+			})},
+			err: "failed to convert AlertPolicy web at spec.conditions.0.metadata.annotations:" +
+				" unsupported 'nobl9.com/measurement' annotation value 'budgetDrop', must be one of:" +
+				" averageBurnRate, burnedBudget, timeToBurnBudget, timeToBurnEntireBudget",
+		},
+		"operator not matching AlertPolicy condition measurement": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				condition := policy.Spec.Conditions[0].AlertPolicyConditionInline
+				condition.Metadata.Annotations = v1.Annotations{DomainNobl9 + "/measurement": "timeToBurnBudget"}
+			})},
+			err: "failed to convert AlertPolicy web at spec.conditions.0.spec.condition.op:" +
+				" measurement 'timeToBurnBudget' requires 'lt' operator, got 'gte'",
+		},
+		"months in AlertPolicy condition lookback window": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				policy.Spec.Conditions[0].AlertPolicyConditionInline.Spec.Condition.LookbackWindow =
+					v1.NewDurationShorthand(1, v1.DurationShorthandUnitMonth)
+			})},
+			err: "failed to convert AlertPolicy web at spec.conditions.0.spec.condition.lookbackWindow:" +
+				" duration unit 'M' cannot be converted to Nobl9 duration, must be one of: m, h, d, w",
+		},
+		"AlertPolicy cool down too short": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				policy.Metadata.Annotations = v1.Annotations{DomainNobl9 + "/spec.coolDown": "1m"}
+			})},
+			err: "failed to convert AlertPolicy web at metadata.annotations:" +
+				" invalid 'nobl9.com/spec.coolDown' annotation: cool down '1m' must be at least 5m",
+		},
+		"invalid AlertPolicy cool down": {
+			objects: []openslo.Object{newTestAlertPolicy(func(policy *v1.AlertPolicy) {
+				policy.Metadata.Annotations = v1.Annotations{DomainNobl9 + "/spec.coolDown": "soon"}
+			})},
+			err: "failed to convert AlertPolicy web at metadata.annotations:" +
+				" invalid 'nobl9.com/spec.coolDown' annotation:" +
+				" invalid duration shorthand: soon, expected [0-9]+[mhdwMQY]",
+		},
+		"invalid JSON alert method annotation": {
+			objects: []openslo.Object{v1.NewAlertNotificationTarget(
+				v1.Metadata{Name: "on-call", Annotations: v1.Annotations{
+					DomainNobl9 + "/spec.slack": "https://hooks.slack.com/services/123",
+				}},
+				v1.AlertNotificationTargetSpec{Target: "slack"},
+			)},
+			err: "failed to convert AlertNotificationTarget on-call at metadata.annotations:" +
+				" 'nobl9.com/spec.slack' annotation must be a JSON object",
+		},
+		"missing required alert method fields": {
+			objects: []openslo.Object{v1.NewAlertNotificationTarget(
+				v1.Metadata{Name: "on-call", Annotations: v1.Annotations{
+					DomainNobl9 + "/spec.jira": `{"url":"https://jira.example.com","username":"my-user"}`,
+				}},
+				v1.AlertNotificationTargetSpec{Target: "jira"},
+			)},
+			err: "failed to convert AlertNotificationTarget on-call at spec.target:" +
+				" jira AlertMethod requires 'spec.jira.apiToken', 'spec.jira.projectKey'," +
+				" define them with 'nobl9.com/spec.jira' annotation",
+		},
+		"invalid alert method spec": {
+			objects: []openslo.Object{v1.NewAlertNotificationTarget(
+				v1.Metadata{Name: "on-call", Annotations: v1.Annotations{
+					DomainNobl9 + "/spec.slack": `{"url":"https://example.com"}`,
+				}},
+				v1.AlertNotificationTargetSpec{Target: "slack"},
+			)},
+			err: "failed to convert AlertNotificationTarget on-call at spec:" +
+				" Validation for AlertMethod 'on-call' in project 'default' has failed for the following fields:\n" +
+				"  - 'spec.slack.url':\n" +
+				"    - string must start with 'https://hooks.slack.com/services/' prefix",
+		},
+		"conflicting project details": {
+			objects: []openslo.Object{
+				v1.NewService(v1.Metadata{Name: "web", Annotations: v1.Annotations{
+					DomainNobl9 + "/metadata.project":          "team-a",
+					"project." + DomainNobl9 + "/display-name": "Team A",
+				}}, v1.ServiceSpec{}),
+				v1.NewService(v1.Metadata{Name: "frontend", Annotations: v1.Annotations{
+					DomainNobl9 + "/metadata.project":          "team-a",
+					"project." + DomainNobl9 + "/display-name": "Team Alpha",
+				}}, v1.ServiceSpec{}),
+			},
+			options: []Option{WithProjectGeneration(nil)},
+			err: "failed to convert Service frontend at" +
+				" metadata.annotations.project.nobl9.com/display-name: project 'team-a' is already defined" +
+				" with 'project.nobl9.com/display-name' value 'Team A' by another Service",
+		},
+		"AlertPolicy used by SLOs from different projects": {
+			objects: []openslo.Object{
+				newTestSLO(func(slo *v1.SLO) {
+					slo.Metadata.Labels = v1.Labels{"team": {"team-a"}}
+					slo.Spec.AlertPolicies = []v1.SLOAlertPolicy{
+						{SLOAlertPolicyRef: &v1.SLOAlertPolicyRef{AlertPolicyRef: "web"}},
+					}
+				}),
+				newTestSLO(func(slo *v1.SLO) {
+					slo.Metadata.Name = "web-availability"
+					slo.Metadata.Labels = v1.Labels{"team": {"team-b"}}
+					slo.Spec.AlertPolicies = []v1.SLOAlertPolicy{
+						{SLOAlertPolicyRef: &v1.SLOAlertPolicyRef{AlertPolicyRef: "web"}},
+					}
+				}),
+				newTestAlertPolicy(nil),
+			},
+			options: []Option{WithProjectResolvers(ProjectFromLabel("team"))},
+			err: "failed to convert AlertPolicy web: AlertPolicy is used by SLOs" +
+				" from different projects: team-a, team-b, Nobl9 SLOs can only use AlertPolicies from their own project," +
+				" define separate AlertPolicies for each project",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertWithOptions(tc.objects, tc.options...)
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestConvertWithOptions(t *testing.T) {
+	service := v1.NewService(v1.Metadata{Name: "web"}, v1.ServiceSpec{})
+	sli := v1.NewSLI(v1.Metadata{Name: "web-latency"}, v1.SLISpec{
+		ThresholdMetric: &v1.SLIMetricSpec{
+			MetricSource: v1.SLIMetricSource{
+				MetricSourceRef: "prometheus",
+				Type:            "prometheus",
+				Spec:            map[string]any{"promql": `api_server_requestMsec{job="nginx"}`},
+			},
+		},
+	})
+	unsupported := unsupportedObject{
+		APIVersion: openslo.VersionV1alpha,
+		Kind:       openslo.KindDataSource,
+		Name:       "foo",
+	}
+
+	t.Run("default project", func(t *testing.T) {
+		objects, err := ConvertWithOptions([]openslo.Object{service}, WithDefaultProject("my-project"))
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "my-project", objects[0].(manifest.ProjectScopedObject).GetProject())
+	})
+	t.Run("warning is logged", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, nil))
+		objects, err := ConvertWithOptions([]openslo.Object{service, sli}, WithLogger(logger))
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Contains(t, buf.String(), "openslo/v1 SLI is not referenced by any SLO, object skipped")
+		assert.Contains(t, buf.String(), "name=web-latency")
+	})
+	t.Run("warning is not logged by default", func(t *testing.T) {
+		var buf bytes.Buffer
+		defaultLogger := slog.Default()
+		slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+		t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+		_, err := ConvertWithOptions([]openslo.Object{service, sli})
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+	t.Run("strict mode fails on warning", func(t *testing.T) {
+		_, err := ConvertWithOptions([]openslo.Object{service, sli}, WithStrictMode())
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert SLI web-latency: strict mode does not allow warnings:"+
+			" openslo/v1 SLI is not referenced by any SLO, object skipped")
+	})
+	t.Run("standalone object fails", func(t *testing.T) {
+		_, err := ConvertWithOptions([]openslo.Object{service, sli}, WithStandaloneObjectHandling(StandaloneObjectFail))
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert SLI web-latency: standalone object is not supported:"+
+			" openslo/v1 SLI is not referenced by any SLO")
+		assert.ErrorIs(t, err, errStandaloneObject)
+	})
+	t.Run("unsupported kind fails by default", func(t *testing.T) {
+		_, err := ConvertWithOptions([]openslo.Object{service, unsupported})
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert DataSource foo: unsupported kind DataSource for version openslo/v1alpha")
+	})
+	t.Run("unsupported kind is skipped", func(t *testing.T) {
+		objects, err := ConvertWithOptions(
+			[]openslo.Object{service, unsupported},
+			WithUnsupportedKindHandling(UnsupportedKindSkip),
+		)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "web", objects[0].GetName())
+	})
+}
+
+func TestConverter_ConvertWithReport(t *testing.T) {
+	service := v1.NewService(
+		v1.Metadata{Name: "web", Annotations: v1.Annotations{DomainNobl9 + "/metadata.project": "my-project"}},
+		v1.ServiceSpec{},
+	)
+	sli := v1.NewSLI(v1.Metadata{Name: "web-latency"}, v1.SLISpec{
+		ThresholdMetric: &v1.SLIMetricSpec{
+			MetricSource: v1.SLIMetricSource{
+				MetricSourceRef: "prometheus",
+				Type:            "prometheus",
+				Spec:            map[string]any{"promql": `api_server_requestMsec{job="nginx"}`},
+			},
+		},
+	})
+	slo := v1.NewSLO(
+		v1.Metadata{
+			Name: "web-latency",
+			Annotations: v1.Annotations{
+				DomainNobl9 + "/spec.indicator.metricSource.kind":    "Agent",
+				DomainNobl9 + "/spec.indicator.metricSource.project": "prometheus",
+			},
+		},
+		v1.SLOSpec{
+			Service:         "web",
+			BudgetingMethod: v1.SLOBudgetingMethodTimeslices,
+			IndicatorRef:    ptr("web-latency"),
+			TimeWindow: []v1.SLOTimeWindow{
+				{
+					Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+					IsRolling: true,
+				},
+			},
+			Objectives: []v1.SLOObjective{
+				{
+					DisplayName:     "Good",
+					Target:          ptr(0.995),
+					Value:           ptr(200.0),
+					Operator:        v1.OperatorLT,
+					TimeSliceTarget: ptr(0.95),
+					TimeSliceWindow: ptr(v1.NewDurationShorthand(1, v1.DurationShorthandUnitMinute)),
+				},
+			},
+		},
+	)
+	converter := NewConverter()
+
+	t.Run("skipped kind", func(t *testing.T) {
+		_, report, err := converter.ConvertWithReport([]openslo.Object{service, sli})
+		require.NoError(t, err)
+		assert.Equal(t, []Warning{
+			{
+				Kind:    openslo.KindSLI,
+				Name:    "web-latency",
+				Code:    WarningCodeSkippedKind,
+				Message: "openslo/v1 SLI is not referenced by any SLO, object skipped",
+			},
+		}, report.Warnings)
+	})
+	t.Run("standalone alert condition", func(t *testing.T) {
+		alertCondition := v1.NewAlertCondition(v1.Metadata{Name: "fast-burn"}, v1.AlertConditionSpec{
+			Severity: "page",
+			Condition: v1.AlertConditionType{
+				Kind:           v1.AlertConditionKindBurnRate,
+				Operator:       v1.OperatorGTE,
+				Threshold:      ptr(2.0),
+				LookbackWindow: v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+			},
+		})
+		_, report, err := converter.ConvertWithReport([]openslo.Object{service, alertCondition})
+		require.NoError(t, err)
+		assert.Equal(t, []Warning{
+			{
+				Kind:    openslo.KindAlertCondition,
+				Name:    "fast-burn",
+				Code:    WarningCodeSkippedKind,
+				Message: "openslo/v1 AlertCondition is not referenced by any AlertPolicy, object skipped",
+			},
+		}, report.Warnings)
+	})
+	t.Run("dropped field and defaulted value", func(t *testing.T) {
+		_, report, err := converter.ConvertWithReport([]openslo.Object{slo, sli})
+		require.NoError(t, err)
+		assert.Equal(t, []Warning{
+			{
+				Kind:    openslo.KindSLO,
+				Name:    "web-latency",
+				Path:    "spec.objectives.0.timeSliceWindow",
+				Code:    WarningCodeDroppedField,
+				Message: "Nobl9 does not support 'spec.objectives.0.timeSliceWindow', the value was dropped",
+			},
+			{
+				Kind:    openslo.KindSLO,
+				Name:    "web-latency",
+				Path:    "metadata.annotations.nobl9.com/metadata.project",
+				Code:    WarningCodeDefaultedValue,
+				Message: "project was not provided, defaulted to 'default'",
+			},
+		}, report.Warnings)
+	})
+	t.Run("dropped null value", func(t *testing.T) {
+		sloWithNull := newTestSLO(func(slo *v1.SLO) {
+			slo.Spec.Indicator.Metadata.Labels = v1.Labels{"team": nil}
+		})
+		_, report, err := converter.ConvertWithReport([]openslo.Object{sloWithNull})
+		require.NoError(t, err)
+		assert.Contains(t, report.Warnings, Warning{
+			Kind:    openslo.KindSLO,
+			Name:    "web-latency",
+			Path:    "spec.indicator.metadata.labels.team",
+			Code:    WarningCodeDroppedField,
+			Message: "Nobl9 annotations cannot hold null values, the value was dropped",
+		})
+	})
+	t.Run("strict mode fails on dropped field", func(t *testing.T) {
+		_, _, err := NewConverter(WithStrictMode()).ConvertWithReport([]openslo.Object{slo, sli})
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert SLO web-latency at spec.objectives.0.timeSliceWindow:"+
+			" strict mode does not allow warnings:"+
+			" Nobl9 does not support 'spec.objectives.0.timeSliceWindow', the value was dropped")
+		var conversionErr *ObjectConversionError
+		require.ErrorAs(t, err, &conversionErr)
+		assert.Equal(t, openslo.KindSLO, conversionErr.Kind)
+		assert.Equal(t, "web-latency", conversionErr.Name)
+		assert.Equal(t, "spec.objectives.0.timeSliceWindow", conversionErr.Path)
+		assert.ErrorIs(t, err, errStrictMode)
+	})
+	t.Run("errors of all objects are aggregated", func(t *testing.T) {
+		unsupported := unsupportedObject{
+			APIVersion: openslo.VersionV1alpha,
+			Kind:       openslo.KindDataSource,
+			Name:       "foo",
+		}
+		_, _, err := NewConverter(WithStrictMode()).ConvertWithReport([]openslo.Object{slo, sli, unsupported})
+		require.Error(t, err)
+		var conversionErrs ObjectConversionErrors
+		require.ErrorAs(t, err, &conversionErrs)
+		require.Len(t, conversionErrs, 2)
+		assert.Equal(t, "web-latency", conversionErrs[0].Name)
+		assert.Equal(t, "spec.objectives.0.timeSliceWindow", conversionErrs[0].Path)
+		assert.Equal(t, "foo", conversionErrs[1].Name)
+		assert.ErrorIs(t, conversionErrs[1], errUnsupportedKind)
+	})
+}
+
+func TestConverter_ResolveMetricSource(t *testing.T) {
+	sli := v1.NewSLI(v1.Metadata{Name: "web-latency"}, v1.SLISpec{
+		ThresholdMetric: &v1.SLIMetricSpec{
+			MetricSource: v1.SLIMetricSource{
+				MetricSourceRef: "prometheus",
+				Type:            "prometheus",
+				Spec:            map[string]any{"promql": `api_server_requestMsec{job="nginx"}`},
+			},
+		},
+	})
+	slo := v1.NewSLO(
+		v1.Metadata{
+			Name:        "web-latency",
+			Annotations: v1.Annotations{DomainNobl9 + "/metadata.project": "web"},
+		},
+		v1.SLOSpec{
+			Service:         "web",
+			BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+			IndicatorRef:    ptr("web-latency"),
+			TimeWindow: []v1.SLOTimeWindow{
+				{
+					Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+					IsRolling: true,
+				},
+			},
+			Objectives: []v1.SLOObjective{{Target: ptr(0.995), Value: ptr(200.0), Operator: v1.OperatorLT}},
+		},
+	)
+	dataSource := v1.NewDataSource(
+		v1.Metadata{
+			Name:        "prometheus",
+			Annotations: v1.Annotations{DomainNobl9 + "/metadata.project": "monitoring"},
+		},
+		v1.DataSourceSpec{
+			Type:              "prometheus",
+			ConnectionDetails: json.RawMessage(`{"url":"https://prometheus.example.com"}`),
+		},
+	)
+	converter := NewConverter()
+
+	t.Run("kind and project are taken from the data source", func(t *testing.T) {
+		objects, report, err := converter.ConvertWithReport([]openslo.Object{slo, sli, dataSource})
+		require.NoError(t, err)
+		assert.Empty(t, report.Warnings)
+		require.Len(t, objects, 2)
+		nobl9SLO, ok := objects[0].(v1alphaSLO.SLO)
+		require.True(t, ok)
+		assert.Equal(t, v1alphaSLO.MetricSourceSpec{
+			Name:    "prometheus",
+			Project: "monitoring",
+			Kind:    manifest.KindAgent,
+		}, nobl9SLO.Spec.Indicator.MetricSource)
+	})
+	t.Run("explicit annotations take precedence", func(t *testing.T) {
+		annotated := slo
+		annotated.Metadata.Annotations = v1.Annotations{
+			DomainNobl9 + "/metadata.project":                    "web",
+			DomainNobl9 + "/spec.indicator.metricSource.project": "other",
+		}
+		objects, err := converter.Convert([]openslo.Object{annotated, sli, dataSource})
+		require.NoError(t, err)
+		nobl9SLO, ok := objects[0].(v1alphaSLO.SLO)
+		require.True(t, ok)
+		assert.Equal(t, "other", nobl9SLO.Spec.Indicator.MetricSource.Project)
+		assert.Equal(t, manifest.KindAgent, nobl9SLO.Spec.Indicator.MetricSource.Kind)
+	})
+	t.Run("unresolved data source", func(t *testing.T) {
+		_, report, err := converter.ConvertWithReport([]openslo.Object{slo, sli})
+		require.NoError(t, err)
+		assert.Equal(t, []Warning{
+			{
+				Kind:    openslo.KindSLO,
+				Name:    "web-latency",
+				Path:    "spec.indicator.spec.thresholdMetric.metricSource.metricSourceRef",
+				Code:    WarningCodeUnresolvedReference,
+				Message: "referenced DataSource 'prometheus' was not found, metric source kind and project were not set",
+			},
+		}, report.Warnings)
+	})
+	t.Run("strict mode fails on unresolved data source", func(t *testing.T) {
+		_, err := NewConverter(WithStrictMode()).Convert([]openslo.Object{slo, sli})
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert SLO web-latency"+
+			" at spec.indicator.spec.thresholdMetric.metricSource.metricSourceRef:"+
+			" strict mode does not allow warnings:"+
+			" referenced DataSource 'prometheus' was not found, metric source kind and project were not set")
+	})
+	t.Run("metrics reference different data sources", func(t *testing.T) {
+		ratio := newTestSLO(func(slo *v1.SLO) {
+			slo.Spec.Indicator.Spec = v1.SLISpec{
+				RatioMetric: &v1.SLIRatioMetric{
+					Counter: true,
+					Good: &v1.SLIMetricSpec{MetricSource: v1.SLIMetricSource{
+						MetricSourceRef: "ds-a",
+						Type:            "prometheus",
+						Spec:            map[string]any{"promql": `http_requests_total{code="200"}`},
+					}},
+					Total: &v1.SLIMetricSpec{MetricSource: v1.SLIMetricSource{
+						MetricSourceRef: "ds-b",
+						Type:            "prometheus",
+						Spec:            map[string]any{"promql": `http_requests_total`},
+					}},
+				},
+			}
+			slo.Spec.Objectives = []v1.SLOObjective{{Target: ptr(0.995)}}
+		})
+		_, err := converter.Convert([]openslo.Object{ratio})
+		require.Error(t, err)
+		assert.EqualError(t, err, "failed to convert SLO web-latency"+
+			" at spec.indicator.spec.ratioMetric.total.metricSource.metricSourceRef:"+
+			" Nobl9 SLO has a single metric source, all metrics must reference the same DataSource,"+
+			" expected 'ds-a', got 'ds-b'")
+		var conversionErr *ObjectConversionError
+		require.ErrorAs(t, err, &conversionErr)
+		assert.Equal(t, "spec.indicator.spec.ratioMetric.total.metricSource.metricSourceRef", conversionErr.Path)
+	})
+}
+
+func TestConverter_ConversionRules(t *testing.T) {
+	service := v1.NewService(
+		v1.Metadata{Name: "web", Annotations: v1.Annotations{"example.com/team": "team-a"}},
+		v1.ServiceSpec{Description: "Web service"},
+	)
+	upperCase := conversionrules.Value(func(v any) (any, error) {
+		return strings.ToUpper(v.(string)), nil
+	})
+	teamLabel := conversionrules.Custom(func(jsonObject, _ string, v any) (string, error) {
+		return sjson.Set(jsonObject, "metadata.labels.team", []any{v})
+	})
+
+	for name, test := range map[string]struct {
+		options  []Option
+		expected v1alphaService.Service
+	}{
+		"built-in rules": {
+			expected: v1alphaService.New(
+				v1alphaService.Metadata{
+					Name:        "web",
+					Project:     "default",
+					Annotations: nobl9v1alpha.MetadataAnnotations{"example.com/team": "team-a"},
+				},
+				v1alphaService.Spec{Description: "Web service"},
+			),
+		},
+		"override and add rules": {
+			options: []Option{
+				WithConversionRules(openslo.VersionV1, openslo.KindService, conversionrules.Rules{
+					"spec.description":                      upperCase,
+					"metadata.annotations.example.com/team": teamLabel,
+				}),
+			},
+			expected: v1alphaService.New(
+				v1alphaService.Metadata{
+					Name:        "web",
+					Project:     "default",
+					Labels:      nobl9v1alpha.Labels{"team": {"team-a"}},
+					Annotations: nobl9v1alpha.MetadataAnnotations{"example.com/team": "team-a"},
+				},
+				v1alphaService.Spec{Description: "WEB SERVICE"},
+			),
+		},
+		"later rules take precedence": {
+			options: []Option{
+				WithConversionRules(openslo.VersionV1, openslo.KindService, conversionrules.Rules{
+					"spec.description": upperCase,
+				}),
+				WithConversionRules(openslo.VersionV1, openslo.KindService, conversionrules.Rules{
+					"spec.description": conversionrules.Path("metadata.displayName"),
+				}),
+			},
+			expected: v1alphaService.New(
+				v1alphaService.Metadata{
+					Name:        "web",
+					DisplayName: "Web service",
+					Project:     "default",
+					Annotations: nobl9v1alpha.MetadataAnnotations{"example.com/team": "team-a"},
+				},
+				v1alphaService.Spec{},
+			),
+		},
+		"rules of other kinds are not applied": {
+			options: []Option{
+				WithConversionRules(openslo.VersionV2alpha, openslo.KindService, conversionrules.Rules{
+					"spec.description": upperCase,
+				}),
+				WithConversionRules(openslo.VersionV1, openslo.KindSLO, conversionrules.Rules{
+					"spec.description": upperCase,
+				}),
+			},
+			expected: v1alphaService.New(
+				v1alphaService.Metadata{
+					Name:        "web",
+					Project:     "default",
+					Annotations: nobl9v1alpha.MetadataAnnotations{"example.com/team": "team-a"},
+				},
+				v1alphaService.Spec{Description: "Web service"},
+			),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			objects, err := ConvertWithOptions([]openslo.Object{service}, test.options...)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			converted, ok := objects[0].(v1alphaService.Service)
+			require.True(t, ok)
+			delete(converted.Metadata.Annotations, DomainOpenSLO+"/apiVersion")
+			assert.Equal(t, test.expected, converted)
+		})
+	}
+}
+
+// unsupportedObject is an OpenSLO object for which no conversion rules exist.
+type unsupportedObject struct {
+	APIVersion openslo.Version `json:"apiVersion"`
+	Kind       openslo.Kind    `json:"kind"`
+	Name       string          `json:"name"`
+}
+
+func (u unsupportedObject) GetVersion() openslo.Version { return u.APIVersion }
+func (u unsupportedObject) GetKind() openslo.Kind       { return u.Kind }
+func (u unsupportedObject) GetName() string             { return u.Name }
+func (u unsupportedObject) Validate() error             { return nil }
+func (u unsupportedObject) String() string              { return u.Name }
+
 func listAllFilesInDir(t *testing.T, dir string) []string {
 	t.Helper()
 
@@ -376,4 +1496,94 @@ func listAllFilesInDir(t *testing.T, dir string) []string {
 	return files
 }
 
+// newTestSLO returns a valid v1 SLO, the test cases adjust it with the modify function.
+func newTestSLO(modify func(slo *v1.SLO)) v1.SLO {
+	slo := v1.NewSLO(
+		v1.Metadata{Name: "web-latency"},
+		v1.SLOSpec{
+			Service:         "web",
+			BudgetingMethod: v1.SLOBudgetingMethodOccurrences,
+			Indicator: &v1.SLOIndicatorInline{
+				Metadata: v1.Metadata{Name: "web-latency"},
+				Spec: v1.SLISpec{
+					ThresholdMetric: &v1.SLIMetricSpec{
+						MetricSource: v1.SLIMetricSource{
+							MetricSourceRef: "prometheus",
+							Type:            "prometheus",
+							Spec:            map[string]any{"promql": `api_server_requestMsec{job="nginx"}`},
+						},
+					},
+				},
+			},
+			TimeWindow: []v1.SLOTimeWindow{{
+				Duration:  v1.NewDurationShorthand(1, v1.DurationShorthandUnitDay),
+				IsRolling: true,
+			}},
+			Objectives: []v1.SLOObjective{{Target: ptr(0.995), Value: ptr(200.0), Operator: v1.OperatorLT}},
+		},
+	)
+	if modify != nil {
+		modify(&slo)
+	}
+	return slo
+}
+
+// newTestV1alphaSLO returns a valid v1alpha SLO, the test cases adjust it with the modify function.
+func newTestV1alphaSLO(modify func(slo *v1alpha.SLO)) v1alpha.SLO {
+	slo := v1alpha.NewSLO(
+		v1alpha.Metadata{Name: "web-latency"},
+		v1alpha.SLOSpec{
+			Service: "web",
+			Indicator: &v1alpha.SLOIndicator{
+				ThresholdMetric: v1alpha.SLOMetricSourceSpec{
+					Source:    "prometheus",
+					QueryType: "promql",
+					Query:     `api_server_requestMsec{job="nginx"}`,
+				},
+			},
+			TimeWindows:     []v1alpha.SLOTimeWindow{{Unit: v1alpha.SLOTimeWindowUnitDay, Count: 1, IsRolling: true}},
+			BudgetingMethod: v1alpha.SLOBudgetingMethodOccurrences,
+			Objectives: []v1alpha.SLOObjective{
+				{DisplayName: "Good", Value: ptr(200.0), Operator: v1alpha.OperatorLT, BudgetTarget: ptr(0.995)},
+			},
+		},
+	)
+	if modify != nil {
+		modify(&slo)
+	}
+	return slo
+}
+
+// newTestAlertPolicy returns a valid v1 AlertPolicy with a single inline burn rate condition,
+// the test cases adjust it with the modify function.
+func newTestAlertPolicy(modify func(policy *v1.AlertPolicy)) v1.AlertPolicy {
+	policy := v1.NewAlertPolicy(
+		v1.Metadata{Name: "web"},
+		v1.AlertPolicySpec{
+			Conditions: []v1.AlertPolicyCondition{
+				{AlertPolicyConditionInline: &v1.AlertPolicyConditionInline{
+					Kind:     openslo.KindAlertCondition,
+					Metadata: v1.Metadata{Name: "fast-burn"},
+					Spec: v1.AlertConditionSpec{
+						Severity: "High",
+						Condition: v1.AlertConditionType{
+							Kind:           v1.AlertConditionKindBurnRate,
+							Operator:       v1.OperatorGTE,
+							Threshold:      ptr(2.0),
+							LookbackWindow: v1.NewDurationShorthand(1, v1.DurationShorthandUnitHour),
+						},
+					},
+				}},
+			},
+			NotificationTargets: []v1.AlertPolicyNotificationTarget{
+				{AlertPolicyNotificationTargetRef: &v1.AlertPolicyNotificationTargetRef{TargetRef: "on-call"}},
+			},
+		},
+	)
+	if modify != nil {
+		modify(&policy)
+	}
+	return policy
+}
+
 func ptr[T any](v T) *T { return &v }
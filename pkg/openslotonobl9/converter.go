@@ -0,0 +1,185 @@
+package openslotonobl9
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+
+	"github.com/nobl9/nobl9-openslo/pkg/conversionrules"
+)
+
+const defaultProject = "default"
+
+var (
+	errStrictMode       = errors.New("strict mode does not allow warnings")
+	errStandaloneObject = errors.New("standalone object is not supported")
+)
+
+// UnsupportedKindHandling defines how [Converter] treats OpenSLO kinds
+// which have no Nobl9 counterpart for the given API version.
+type UnsupportedKindHandling int
+
+const (
+	// UnsupportedKindFail fails the conversion.
+	UnsupportedKindFail UnsupportedKindHandling = iota
+	// UnsupportedKindSkip skips the object and emits a warning.
+	UnsupportedKindSkip
+)
+
+// StandaloneObjectHandling defines how [Converter] treats OpenSLO SLI and AlertCondition objects
+// which are not referenced by any SLO or AlertPolicy.
+// Nobl9 has no standalone counterpart for these kinds, they only exist as a part of other objects.
+type StandaloneObjectHandling int
+
+const (
+	// StandaloneObjectWarn skips the object and emits a warning.
+	StandaloneObjectWarn StandaloneObjectHandling = iota
+	// StandaloneObjectFail fails the conversion.
+	StandaloneObjectFail
+)
+
+// Option configures [Converter].
+type Option func(c *Converter)
+
+// WithDefaultProject sets the Nobl9 project assigned to objects which don't define
+// 'nobl9.com/metadata.project' annotation and whose project is not resolved, see [WithProjectResolvers].
+// Defaults to "default".
+func WithDefaultProject(project string) Option {
+	return func(c *Converter) { c.defaultProject = project }
+}
+
+// WithProjectResolvers sets the resolvers which assign Nobl9 projects to objects which don't define
+// 'nobl9.com/metadata.project' annotation, the first resolved project is used.
+// AlertPolicies and AlertNotificationTargets inherit the project of the SLOs and AlertPolicies
+// which use them before the resolvers are tried.
+// Objects whose project is not resolved are assigned the default project, see [WithDefaultProject].
+func WithProjectResolvers(resolvers ...ProjectResolver) Option {
+	return func(c *Converter) { c.projectResolvers = resolvers }
+}
+
+// WithLogger sets the logger which receives conversion warnings.
+// By default, warnings are not logged, they are only returned in the [ConversionReport].
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Converter) { c.logger = logger }
+}
+
+// WithStrictMode turns conversion warnings which indicate a loss of information into errors.
+// Only [WarningCodeDefaultedValue] warnings are still reported.
+func WithStrictMode() Option {
+	return func(c *Converter) { c.strict = true }
+}
+
+// WithUnsupportedKindHandling defines how to treat objects of unsupported kinds.
+// Defaults to [UnsupportedKindFail].
+func WithUnsupportedKindHandling(handling UnsupportedKindHandling) Option {
+	return func(c *Converter) { c.unsupportedKind = handling }
+}
+
+// WithStandaloneObjectHandling defines how to treat SLI and AlertCondition objects
+// which are not referenced by any other object.
+// Defaults to [StandaloneObjectWarn].
+func WithStandaloneObjectHandling(handling StandaloneObjectHandling) Option {
+	return func(c *Converter) { c.standaloneObject = handling }
+}
+
+// WithProjectGeneration enables generation of Nobl9 Project objects for every project
+// which the converted objects belong to or reference.
+// Project display name and description are taken from the provided details,
+// falling back to 'project.nobl9.com/display-name' and 'project.nobl9.com/description'
+// annotations of the Services which belong to the project.
+func WithProjectGeneration(details map[string]ProjectDetails) Option {
+	return func(c *Converter) {
+		c.generateProjects = true
+		c.projectDetails = details
+	}
+}
+
+// WithConversionRules adds conversion rules for the objects of the given OpenSLO version and kind.
+// A rule replaces the built-in rule defined for the same path, other rules are added to the built-in ones.
+// Exact paths take precedence over paths with '#' wildcards, regardless of whether the rule is built-in.
+// If multiple paths with wildcards match, the most specific one is used, see [conversionrules.Rules.Match].
+// If the option is used multiple times for the same version and kind, the later rules take precedence.
+// Only the kinds which are converted to Nobl9 objects can have their rules overridden.
+func WithConversionRules(version openslo.Version, kind openslo.Kind, rules conversionrules.Rules) Option {
+	return func(c *Converter) {
+		key := conversionRulesKey{version: version, kind: kind}
+		if c.conversionRules == nil {
+			c.conversionRules = make(map[conversionRulesKey]conversionrules.Rules)
+		}
+		c.conversionRules[key] = mergeConversionRules(c.conversionRules[key], rules)
+	}
+}
+
+// WithMetricTypeAliases sets aliases of OpenSLO metric source types, keyed by the alias, which
+// are converted to the given Nobl9 metric types, for example "my-prometheus": "prometheus".
+// Aliases are matched ignoring case and '-', '_', '.' and ' ' separators,
+// they take precedence over the built-in aliases.
+// If the option is used multiple times, the aliases are merged and the later ones take precedence.
+func WithMetricTypeAliases(aliases map[string]string) Option {
+	return func(c *Converter) {
+		if c.metricTypeAliases == nil {
+			c.metricTypeAliases = make(map[string]string, len(aliases))
+		}
+		for alias, typ := range aliases {
+			c.metricTypeAliases[metricTypeKey(alias)] = typ
+		}
+	}
+}
+
+// conversionRulesKey identifies the conversion rules set with [WithConversionRules].
+type conversionRulesKey struct {
+	version openslo.Version
+	kind    openslo.Kind
+}
+
+// Converter converts OpenSLO objects to Nobl9 objects.
+// Use [NewConverter] to create a new instance.
+type Converter struct {
+	defaultProject   string
+	projectResolvers []ProjectResolver
+	logger           *slog.Logger
+	strict           bool
+	unsupportedKind  UnsupportedKindHandling
+	standaloneObject StandaloneObjectHandling
+	generateProjects bool
+	projectDetails   map[string]ProjectDetails
+	conversionRules  map[conversionRulesKey]conversionrules.Rules
+	// metricTypeAliases are keyed by the alias normalized with [metricTypeKey].
+	metricTypeAliases map[string]string
+}
+
+// NewConverter creates a new [Converter] configured with the provided options.
+func NewConverter(options ...Option) *Converter {
+	c := &Converter{
+		defaultProject:   defaultProject,
+		logger:           slog.New(slog.DiscardHandler),
+		unsupportedKind:  UnsupportedKindFail,
+		standaloneObject: StandaloneObjectWarn,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// warn adds the warning to the report and logs it.
+// In strict mode, warnings which indicate a loss of information are returned as an error instead.
+func (c *Converter) warn(report *ConversionReport, warning Warning) error {
+	if c.strict && warning.Code != WarningCodeDefaultedValue {
+		return &ObjectConversionError{
+			Kind:  warning.Kind,
+			Name:  warning.Name,
+			Path:  warning.Path,
+			Cause: fmt.Errorf("%w: %s", errStrictMode, warning.Message),
+		}
+	}
+	report.Warnings = append(report.Warnings, warning)
+	c.logger.Warn(warning.Message,
+		slog.String("kind", warning.Kind.String()),
+		slog.String("name", warning.Name),
+		slog.String("path", warning.Path),
+		slog.String("code", string(warning.Code)))
+	return nil
+}
@@ -7,11 +7,11 @@ import (
 	"github.com/tidwall/sjson"
 )
 
-func setDefaults(jsonObject string) (result string, err error) {
+func setDefaults(jsonObject, project string) (result string, err error) {
 	if gjson.Get(jsonObject, "metadata.project").String() == "" {
-		jsonObject, err = sjson.Set(jsonObject, "metadata.project", "default")
+		jsonObject, err = sjson.Set(jsonObject, "metadata.project", project)
 		if err != nil {
-			return "", fmt.Errorf("failed to set metadata.project to default: %w", err)
+			return "", fmt.Errorf("failed to set metadata.project to %s: %w", project, err)
 		}
 	}
 	return jsonObject, nil
@@ -0,0 +1,57 @@
+package openslotonobl9
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// ObjectConversionError is returned when an OpenSLO object could not be converted.
+// Validation errors are not wrapped, they are reported as [govy.ValidatorError] instead.
+type ObjectConversionError struct {
+	Kind openslo.Kind `json:"kind"`
+	Name string       `json:"name"`
+	// Path is the OpenSLO path of the failing field, it is empty if the failure is not related to a single field.
+	Path  string `json:"path,omitempty"`
+	Cause error  `json:"-"`
+}
+
+func newObjectConversionError(object openslo.Object, path string, cause error) *ObjectConversionError {
+	return &ObjectConversionError{
+		Kind:  object.GetKind(),
+		Name:  object.GetName(),
+		Path:  path,
+		Cause: cause,
+	}
+}
+
+func (e *ObjectConversionError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("failed to convert %s %s: %v", e.Kind, e.Name, e.Cause)
+	}
+	return fmt.Sprintf("failed to convert %s %s at %s: %v", e.Kind, e.Name, e.Path, e.Cause)
+}
+
+func (e *ObjectConversionError) Unwrap() error {
+	return e.Cause
+}
+
+// ObjectConversionErrors aggregates [ObjectConversionError] of every object which failed to convert.
+type ObjectConversionErrors []*ObjectConversionError
+
+func (e ObjectConversionErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e ObjectConversionErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
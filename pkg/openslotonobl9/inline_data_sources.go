@@ -0,0 +1,150 @@
+package openslotonobl9
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/nobl9/nobl9-go/manifest"
+)
+
+// inlineConnectionDetailsKey is the v1 SLI 'metricSource.spec' key which holds
+// the connection details of an inline data source.
+const inlineConnectionDetailsKey = "connectionDetails"
+
+// inlineDataSourceExtractor replaces inline v1 metric sources with references
+// to generated DataSource objects.
+type inlineDataSourceExtractor struct {
+	defaultProject string
+	projects       objectProjects
+	generated      []openslo.Object
+	names          map[string]struct{}
+}
+
+// extractInlineDataSources returns the objects with inline v1 metric sources replaced by
+// references and the generated DataSource objects appended.
+//
+// A metric source is inline if it has no 'metricSourceRef' and defines 'spec.connectionDetails'.
+// The generated DataSource is named '<type>-<hash>', where the hash is computed from its kind,
+// project, type and connection details, this way identical data sources are generated only once.
+// Its kind and project are taken from 'nobl9.com/spec.indicator.metricSource.kind' and
+// 'nobl9.com/spec.indicator.metricSource.project' SLO annotations, the project defaults to the SLO project.
+func (c *Converter) extractInlineDataSources(
+	objects []openslo.Object,
+	projects objectProjects,
+) ([]openslo.Object, error) {
+	extractor := &inlineDataSourceExtractor{
+		defaultProject: c.defaultProject,
+		projects:       projects,
+		names:          make(map[string]struct{}),
+	}
+	result := make([]openslo.Object, 0, len(objects))
+	for _, object := range objects {
+		slo, ok := object.(v1.SLO)
+		if !ok || slo.Spec.Indicator == nil {
+			result = append(result, object)
+			continue
+		}
+		extracted, err := extractor.extractFromSLO(slo)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, extracted)
+	}
+	return append(result, extractor.generated...), nil
+}
+
+func (e *inlineDataSourceExtractor) extractFromSLO(slo v1.SLO) (v1.SLO, error) {
+	indicator := *slo.Spec.Indicator
+	path := "spec.indicator.spec"
+	var err error
+	indicator.Spec.ThresholdMetric, err = e.extractFromMetric(slo, path+".thresholdMetric", indicator.Spec.ThresholdMetric)
+	if err != nil {
+		return slo, err
+	}
+	if indicator.Spec.RatioMetric != nil {
+		ratio := *indicator.Spec.RatioMetric
+		for _, metric := range []struct {
+			path string
+			spec **v1.SLIMetricSpec
+		}{
+			{path: ".ratioMetric.good", spec: &ratio.Good},
+			{path: ".ratioMetric.bad", spec: &ratio.Bad},
+			{path: ".ratioMetric.total", spec: &ratio.Total},
+			{path: ".ratioMetric.raw", spec: &ratio.Raw},
+		} {
+			if *metric.spec, err = e.extractFromMetric(slo, path+metric.path, *metric.spec); err != nil {
+				return slo, err
+			}
+		}
+		indicator.Spec.RatioMetric = &ratio
+	}
+	slo.Spec.Indicator = &indicator
+	return slo, nil
+}
+
+func (e *inlineDataSourceExtractor) extractFromMetric(
+	slo v1.SLO,
+	path string,
+	metric *v1.SLIMetricSpec,
+) (*v1.SLIMetricSpec, error) {
+	if metric == nil || metric.MetricSource.MetricSourceRef != "" {
+		return metric, nil
+	}
+	connectionDetails, ok := metric.MetricSource.Spec[inlineConnectionDetailsKey]
+	if !ok {
+		return metric, nil
+	}
+	dataSource, err := e.newDataSource(slo, metric.MetricSource.Type, connectionDetails)
+	if err != nil {
+		return nil, newObjectConversionError(slo, path+".metricSource.spec."+inlineConnectionDetailsKey, err)
+	}
+	if _, exists := e.names[dataSource.Metadata.Name]; !exists {
+		e.names[dataSource.Metadata.Name] = struct{}{}
+		e.generated = append(e.generated, dataSource)
+	}
+	extracted := *metric
+	extracted.MetricSource.MetricSourceRef = dataSource.Metadata.Name
+	extracted.MetricSource.Spec = maps.Clone(metric.MetricSource.Spec)
+	delete(extracted.MetricSource.Spec, inlineConnectionDetailsKey)
+	return &extracted, nil
+}
+
+func (e *inlineDataSourceExtractor) newDataSource(
+	slo v1.SLO,
+	typ string,
+	connectionDetails any,
+) (v1.DataSource, error) {
+	// Maps are encoded with sorted keys, which makes the encoded details deterministic.
+	details, err := json.Marshal(connectionDetails)
+	if err != nil {
+		return v1.DataSource{}, fmt.Errorf("failed to encode inline data source connection details: %w", err)
+	}
+	kind := manifest.KindAgent.String()
+	annotations := make(v1.Annotations, 2)
+	if slo.Metadata.Annotations[metricSourceKindAnnotation] == manifest.KindDirect.String() {
+		kind = manifest.KindDirect.String()
+		annotations[DomainNobl9+"/kind"] = kind
+	}
+	project := cmp.Or(
+		slo.Metadata.Annotations[metricSourceProjectAnnotation],
+		e.projects.get(slo),
+		e.defaultProject,
+	)
+	annotations[projectAnnotation] = project
+	hash := sha256.Sum256([]byte(strings.Join([]string{kind, project, typ, string(details)}, "\x00")))
+	name := fmt.Sprintf("%s-%s", strings.ToLower(typ), hex.EncodeToString(hash[:])[:10])
+	return v1.NewDataSource(
+		v1.Metadata{Name: name, Annotations: annotations},
+		v1.DataSourceSpec{
+			Type:              typ,
+			ConnectionDetails: details,
+		},
+	), nil
+}
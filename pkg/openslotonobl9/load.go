@@ -0,0 +1,222 @@
+package openslotonobl9
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// LoadPaths reads OpenSLO objects from all the files matching the provided patterns.
+//
+// Patterns are file paths, directories or doublestar globs, like 'slos/**/*.yaml'.
+// Directories are searched recursively for '.yaml', '.yml' and '.json' files.
+// Files ending with '.json' are decoded as JSON, all other files as YAML.
+// Both multi-document YAML files and JSON arrays are supported.
+//
+// Objects with the same kind and name which are defined multiple times are deduplicated,
+// if their definitions differ, an error listing all the conflicting files is returned.
+// Since all objects are loaded together, references between them can be resolved
+// across files by [Convert].
+func LoadPaths(patterns ...string) ([]openslo.Object, error) {
+	objects, _, err := LoadPathsWithSources(patterns...)
+	return objects, err
+}
+
+// LoadPathsWithSources works like [LoadPaths] and also returns the sources of every object,
+// these are the files it was loaded from, see [ProjectFromSourceDirectory],
+// and the positions of its nodes, see [ObjectSources.Locate].
+func LoadPathsWithSources(patterns ...string) ([]openslo.Object, ObjectSources, error) {
+	files, err := expandPatterns(patterns)
+	if err != nil {
+		return nil, ObjectSources{}, err
+	}
+	loader := newObjectsLoader()
+	for _, file := range files {
+		if err = loader.Load(file); err != nil {
+			return nil, ObjectSources{}, err
+		}
+	}
+	objects, err := loader.Objects()
+	if err != nil {
+		return nil, ObjectSources{}, err
+	}
+	return objects, loader.Sources(), nil
+}
+
+// ObjectSources lists the files OpenSLO objects were loaded from
+// and the positions of their nodes.
+type ObjectSources struct {
+	// files keyed by [objectKey].
+	files map[string][]string
+	// objects nodes of the first definition of every object, in the order the objects were loaded.
+	objects []sourceObject
+}
+
+// Files returns the sorted files the object was loaded from.
+func (s ObjectSources) Files(object openslo.Object) []string {
+	return slices.Clone(s.files[objectKey(object.GetKind(), object.GetName())])
+}
+
+// expandPatterns returns a sorted list of unique files matching the patterns.
+func expandPatterns(patterns []string) ([]string, error) {
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := doublestar.FilepathGlob(pattern, doublestar.WithFailOnIOErrors())
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand pattern '%s': %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match pattern '%s': %w", pattern, fs.ErrNotExist)
+		}
+		for _, match := range matches {
+			matchedFiles, err := listFiles(match)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, matchedFiles...)
+		}
+	}
+	slices.Sort(files)
+	return slices.Compact(files), nil
+}
+
+// listFiles returns the path if it's a file, or all the supported files found in the directory.
+func listFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{filepath.Clean(path)}, nil
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Clean(path))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// objectsLoader decodes OpenSLO objects and deduplicates them by kind and name.
+type objectsLoader struct {
+	objects []openslo.Object
+	// sources of every object, keyed by [objectKey].
+	sources map[string]*objectSource
+}
+
+type objectSource struct {
+	data  []byte
+	files []string
+	// node of the first definition of the object.
+	node sourceObject
+	// conflict is set if the definitions of the object differ between files.
+	conflict bool
+}
+
+func newObjectsLoader() *objectsLoader {
+	return &objectsLoader{sources: make(map[string]*objectSource)}
+}
+
+// Load decodes the file and adds its objects to the loader.
+func (o *objectsLoader) Load(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	format := openslosdk.FormatYAML
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		format = openslosdk.FormatJSON
+	}
+	objects, err := openslosdk.Decode(bytes.NewReader(data), format)
+	if err != nil {
+		return fmt.Errorf("failed to decode OpenSLO objects from %s: %w", file, err)
+	}
+	// Node positions are only used to locate errors, the objects are loaded without them
+	// if the nodes don't match the decoded objects.
+	nodes, err := parseSourceObjects(file, data)
+	if err != nil || len(nodes) != len(objects) {
+		nodes = make([]sourceObject, len(objects))
+	}
+	for i, object := range objects {
+		if err = o.add(file, object, nodes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *objectsLoader) add(file string, object openslo.Object, node sourceObject) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s %s from %s: %w", object.GetKind(), object.GetName(), file, err)
+	}
+	key := objectKey(object.GetKind(), object.GetName())
+	source, ok := o.sources[key]
+	if !ok {
+		o.sources[key] = &objectSource{data: data, files: []string{file}, node: node}
+		o.objects = append(o.objects, object)
+		return nil
+	}
+	if !slices.Contains(source.files, file) {
+		source.files = append(source.files, file)
+	}
+	if !bytes.Equal(source.data, data) {
+		source.conflict = true
+	}
+	return nil
+}
+
+// Objects returns the deduplicated objects in the order they were first loaded,
+// or an error if any of the objects has conflicting definitions.
+func (o *objectsLoader) Objects() ([]openslo.Object, error) {
+	var errs []error
+	for _, object := range o.objects {
+		source := o.sources[objectKey(object.GetKind(), object.GetName())]
+		if source.conflict {
+			errs = append(errs, fmt.Errorf("conflicting definitions of %s %s found in: %s",
+				object.GetKind(), object.GetName(), strings.Join(source.files, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return o.objects, nil
+}
+
+// Sources returns the files every loaded object was found in and the nodes of its first definition.
+func (o *objectsLoader) Sources() ObjectSources {
+	files := make(map[string][]string, len(o.sources))
+	for key, source := range o.sources {
+		files[key] = slices.Clone(source.files)
+	}
+	objects := make([]sourceObject, 0, len(o.objects))
+	for _, object := range o.objects {
+		objects = append(objects, o.sources[objectKey(object.GetKind(), object.GetName())].node)
+	}
+	return ObjectSources{files: files, objects: objects}
+}
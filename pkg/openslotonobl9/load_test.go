@@ -0,0 +1,157 @@
+package openslotonobl9
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const loadTestService = `apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: web
+spec:
+  description: Web service
+`
+
+const loadTestSLIs = `apiVersion: openslo/v1
+kind: SLI
+metadata:
+  name: web-latency
+spec:
+  thresholdMetric:
+    metricSource:
+      metricSourceRef: prometheus
+      type: prometheus
+      spec:
+        promql: api_server_requestMsec{job="nginx"}
+---
+apiVersion: openslo/v1
+kind: SLI
+metadata:
+  name: web-availability
+spec:
+  thresholdMetric:
+    metricSource:
+      metricSourceRef: prometheus
+      type: prometheus
+      spec:
+        promql: api_server_up{job="nginx"}
+`
+
+const loadTestSLO = `[
+  {
+    "apiVersion": "openslo/v1",
+    "kind": "SLO",
+    "metadata": {"name": "web-latency"},
+    "spec": {
+      "service": "web",
+      "indicatorRef": "web-latency",
+      "budgetingMethod": "Occurrences",
+      "timeWindow": [{"duration": "1d", "isRolling": true}],
+      "objectives": [{"target": 0.99, "op": "lt", "value": 200}]
+    }
+  },
+  {
+    "apiVersion": "openslo/v1",
+    "kind": "Service",
+    "metadata": {"name": "web"},
+    "spec": {"description": "Web service"}
+  }
+]`
+
+func TestLoadPaths(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "team-a", "service.yaml"), loadTestService)
+	writeTestFile(t, filepath.Join(dir, "team-a", "slis", "slis.yml"), loadTestSLIs)
+	writeTestFile(t, filepath.Join(dir, "team-b", "slo.json"), loadTestSLO)
+	writeTestFile(t, filepath.Join(dir, "team-b", "README.md"), "# SLOs")
+
+	objectNames := func(objects []openslo.Object) []string {
+		names := make([]string, 0, len(objects))
+		for _, object := range objects {
+			names = append(names, objectKey(object.GetKind(), object.GetName()))
+		}
+		return names
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		objects, err := LoadPaths(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Service/web",
+			"SLI/web-latency",
+			"SLI/web-availability",
+			"SLO/web-latency",
+		}, objectNames(objects))
+	})
+	t.Run("globs", func(t *testing.T) {
+		objects, err := LoadPaths(filepath.Join(dir, "**", "*.yml"), filepath.Join(dir, "team-{a,b}", "*.json"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"SLI/web-latency",
+			"SLI/web-availability",
+			"SLO/web-latency",
+			"Service/web",
+		}, objectNames(objects))
+	})
+	t.Run("references are resolved across files", func(t *testing.T) {
+		objects, err := LoadPaths(dir)
+		require.NoError(t, err)
+		_, err = ConvertWithOptions(objects, WithUnsupportedKindHandling(UnsupportedKindSkip))
+		require.NoError(t, err)
+	})
+	t.Run("projects from source directories", func(t *testing.T) {
+		objects, sources, err := LoadPathsWithSources(dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "team-b", "slo.json")}, sources.Files(objects[3]))
+		nobl9Objects, err := ConvertWithOptions(objects,
+			WithUnsupportedKindHandling(UnsupportedKindSkip),
+			WithProjectResolvers(ProjectFromSourceDirectory(sources)),
+		)
+		require.NoError(t, err)
+		require.Len(t, nobl9Objects, 2)
+		for _, object := range nobl9Objects {
+			scoped, ok := object.(manifest.ProjectScopedObject)
+			require.True(t, ok)
+			switch object.GetKind() {
+			case manifest.KindService:
+				assert.Equal(t, "team-a", scoped.GetProject())
+			case manifest.KindSLO:
+				assert.Equal(t, "team-b", scoped.GetProject())
+			}
+		}
+	})
+	t.Run("no matches", func(t *testing.T) {
+		_, err := LoadPaths(filepath.Join(dir, "**", "*.toml"))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+	t.Run("conflicting duplicates", func(t *testing.T) {
+		conflictingFile := filepath.Join(t.TempDir(), "service.yaml")
+		writeTestFile(t, conflictingFile, strings.ReplaceAll(loadTestService, "Web service", "Web UI"))
+		_, err := LoadPaths(dir, conflictingFile)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "conflicting definitions of Service web found in: ")
+		for _, file := range []string{
+			conflictingFile,
+			filepath.Join(dir, "team-a", "service.yaml"),
+			filepath.Join(dir, "team-b", "slo.json"),
+		} {
+			assert.ErrorContains(t, err, file)
+		}
+	})
+}
+
+func writeTestFile(t *testing.T, path, data string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+}
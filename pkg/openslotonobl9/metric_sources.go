@@ -0,0 +1,173 @@
+package openslotonobl9
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+	"github.com/nobl9/nobl9-go/manifest"
+)
+
+const (
+	metricSourceKindAnnotation    = DomainNobl9 + "/spec.indicator.metricSource.kind"
+	metricSourceProjectAnnotation = DomainNobl9 + "/spec.indicator.metricSource.project"
+)
+
+// dataSourceIdentity identifies the Nobl9 object an OpenSLO DataSource is converted to.
+type dataSourceIdentity struct {
+	kind    manifest.Kind
+	project string
+}
+
+// metricSourceRef is a reference to a DataSource found at the OpenSLO path.
+type metricSourceRef struct {
+	path string
+	name string
+}
+
+// indexDataSources maps the names of OpenSLO DataSource objects to their Nobl9 identity.
+// The kind is taken from 'nobl9.com/kind' annotation and defaults to Agent,
+// the project is the resolved DataSource project and defaults to the [Converter] default project.
+func (c *Converter) indexDataSources(
+	objects []openslo.Object,
+	projects objectProjects,
+) map[string]dataSourceIdentity {
+	dataSources := make(map[string]dataSourceIdentity)
+	for _, object := range objects {
+		var annotations map[string]string
+		switch v := object.(type) {
+		case v1.DataSource:
+			annotations = v.Metadata.Annotations
+		case v2alpha.DataSource:
+			annotations = v.Metadata.Annotations
+		default:
+			continue
+		}
+		identity := dataSourceIdentity{
+			kind:    manifest.KindAgent,
+			project: cmp.Or(projects.get(object), c.defaultProject),
+		}
+		if annotations[DomainNobl9+"/kind"] == manifest.KindDirect.String() {
+			identity.kind = manifest.KindDirect
+		}
+		dataSources[object.GetName()] = identity
+	}
+	return dataSources
+}
+
+// resolveMetricSource sets the Nobl9 metric source kind and project annotations on the SLO
+// based on the DataSource it references.
+// Annotations which were explicitly set on the SLO take precedence.
+// If the referenced DataSource is not a part of the converted objects, a warning is reported.
+// If the metrics reference different DataSources, an error is returned.
+func (c *Converter) resolveMetricSource(
+	object openslo.Object,
+	dataSources map[string]dataSourceIdentity,
+	report *ConversionReport,
+) (openslo.Object, error) {
+	var (
+		refs        []metricSourceRef
+		annotations map[string]string
+	)
+	switch v := object.(type) {
+	case v1.SLO:
+		annotations = v.Metadata.Annotations
+		if v.Spec.Indicator != nil {
+			refs = v1MetricSourceRefs(v.Spec.Indicator.Spec, "spec.indicator.spec")
+		}
+	case v2alpha.SLO:
+		annotations = v.Metadata.Annotations
+		// Composite SLO objectives are resolved separately for each component SLO.
+		if v.Spec.SLI != nil {
+			refs = v2alphaMetricSourceRefs(v.Spec.SLI.Spec, "spec.sli.spec")
+		}
+	default:
+		return object, nil
+	}
+	if len(refs) == 0 {
+		return object, nil
+	}
+	// Nobl9 SLO has a single metric source, all metrics must reference the same DataSource.
+	ref := refs[0]
+	for _, other := range refs[1:] {
+		if other.name != ref.name {
+			return object, newObjectConversionError(object, other.path, fmt.Errorf(
+				"Nobl9 SLO has a single metric source, all metrics must reference the same %s,"+
+					" expected '%s', got '%s'", openslo.KindDataSource, ref.name, other.name))
+		}
+	}
+	_, hasKind := annotations[metricSourceKindAnnotation]
+	_, hasProject := annotations[metricSourceProjectAnnotation]
+	if hasKind && hasProject {
+		return object, nil
+	}
+
+	dataSource, ok := dataSources[ref.name]
+	if !ok {
+		return object, c.warn(report, newWarning(object, ref.path, WarningCodeUnresolvedReference,
+			fmt.Sprintf("referenced %s '%s' was not found, metric source kind and project were not set",
+				openslo.KindDataSource, ref.name)))
+	}
+	resolved := maps.Clone(annotations)
+	if resolved == nil {
+		resolved = make(map[string]string, 2)
+	}
+	if !hasKind {
+		resolved[metricSourceKindAnnotation] = dataSource.kind.String()
+	}
+	if !hasProject {
+		resolved[metricSourceProjectAnnotation] = dataSource.project
+	}
+	switch v := object.(type) {
+	case v1.SLO:
+		v.Metadata.Annotations = resolved
+		return v, nil
+	case v2alpha.SLO:
+		v.Metadata.Annotations = resolved
+		return v, nil
+	}
+	return object, nil
+}
+
+func v1MetricSourceRefs(spec v1.SLISpec, path string) []metricSourceRef {
+	var refs []metricSourceRef
+	add := func(metricPath string, metric *v1.SLIMetricSpec) {
+		if metric != nil && metric.MetricSource.MetricSourceRef != "" {
+			refs = append(refs, metricSourceRef{
+				path: path + metricPath + ".metricSource.metricSourceRef",
+				name: metric.MetricSource.MetricSourceRef,
+			})
+		}
+	}
+	add(".thresholdMetric", spec.ThresholdMetric)
+	if ratio := spec.RatioMetric; ratio != nil {
+		add(".ratioMetric.good", ratio.Good)
+		add(".ratioMetric.bad", ratio.Bad)
+		add(".ratioMetric.total", ratio.Total)
+		add(".ratioMetric.raw", ratio.Raw)
+	}
+	return refs
+}
+
+func v2alphaMetricSourceRefs(spec v2alpha.SLISpec, path string) []metricSourceRef {
+	var refs []metricSourceRef
+	add := func(metricPath string, metric *v2alpha.SLIMetricSpec) {
+		if metric != nil && metric.DataSourceRef != "" {
+			refs = append(refs, metricSourceRef{
+				path: path + metricPath + ".dataSourceRef",
+				name: metric.DataSourceRef,
+			})
+		}
+	}
+	add(".thresholdMetric", spec.ThresholdMetric)
+	if ratio := spec.RatioMetric; ratio != nil {
+		add(".ratioMetric.good", ratio.Good)
+		add(".ratioMetric.bad", ratio.Bad)
+		add(".ratioMetric.total", ratio.Total)
+		add(".ratioMetric.raw", ratio.Raw)
+	}
+	return refs
+}
@@ -0,0 +1,119 @@
+package openslotonobl9
+
+import (
+	"fmt"
+	"maps"
+)
+
+// metricSpecTranslator translates the OpenSLO metric spec to the Nobl9 metric spec.
+// Fields which are not translated are kept as is, if Nobl9 does not define them,
+// they are reported by [validateMetricSpecs].
+type metricSpecTranslator func(spec map[string]any) (map[string]any, error)
+
+// metricSpecTranslators translate the shapes of metric specs commonly used in OpenSLO documents
+// to Nobl9 metric specs, keyed by Nobl9 metric type.
+// Datadog 'query' already matches the Nobl9 spec and needs no translation.
+var metricSpecTranslators = map[string]metricSpecTranslator{
+	"prometheus":       renameMetricSpecFields(map[string]string{"query": "promql"}),
+	"amazonPrometheus": renameMetricSpecFields(map[string]string{"query": "promql"}),
+	"azurePrometheus":  renameMetricSpecFields(map[string]string{"query": "promql"}),
+	"grafanaLoki":      renameMetricSpecFields(map[string]string{"query": "logql"}),
+	"cloudWatch":       translateCloudWatchMetricStat,
+}
+
+// translateMetricSpec translates the OpenSLO metric spec of the given Nobl9 metric type.
+// Specs of types which have no translator are returned as is.
+func translateMetricSpec(metricType string, spec map[string]any) (map[string]any, error) {
+	translator, ok := metricSpecTranslators[metricType]
+	if !ok || spec == nil {
+		return spec, nil
+	}
+	translated, err := translator(maps.Clone(spec))
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate %s metric spec: %w", metricType, err)
+	}
+	return translated, nil
+}
+
+// renameMetricSpecFields moves the values of the spec fields to the fields they're mapped to.
+func renameMetricSpecFields(renames map[string]string) metricSpecTranslator {
+	return func(spec map[string]any) (map[string]any, error) {
+		for from, to := range renames {
+			if err := moveMetricSpecField(spec, from, spec, to); err != nil {
+				return nil, err
+			}
+		}
+		return spec, nil
+	}
+}
+
+// translateCloudWatchMetricStat translates CloudWatch 'metricStat' block, which follows
+// the structure of CloudWatch GetMetricData API, to the flat Nobl9 CloudWatch spec:
+//
+//	metricStat:                 namespace: AWS/ApplicationELB
+//	  metric:                   metricName: HTTPCode_Target_2XX_Count
+//	    namespace: ...     ->   dimensions: [...]
+//	    metricName: ...         stat: SampleCount
+//	    dimensions: [...]
+//	  stat: SampleCount
+//
+// Nobl9 has no counterpart for the remaining 'metricStat' fields, like 'period' or 'unit'.
+func translateCloudWatchMetricStat(spec map[string]any) (map[string]any, error) {
+	metricStat, err := getMetricSpecObject(spec, "metricStat")
+	if err != nil || metricStat == nil {
+		return spec, err
+	}
+	metric, err := getMetricSpecObject(metricStat, "metric")
+	if err != nil {
+		return nil, fmt.Errorf("metricStat.%w", err)
+	}
+	for _, field := range []string{"namespace", "metricName", "dimensions"} {
+		if err = moveMetricSpecField(metric, field, spec, field); err != nil {
+			return nil, fmt.Errorf("metricStat.metric.%w", err)
+		}
+	}
+	if err = moveMetricSpecField(metricStat, "stat", spec, "stat"); err != nil {
+		return nil, fmt.Errorf("metricStat.%w", err)
+	}
+	setMetricSpecObject(metricStat, "metric", metric)
+	setMetricSpecObject(spec, "metricStat", metricStat)
+	return spec, nil
+}
+
+// moveMetricSpecField moves the value of the field from the source to the target field of the destination.
+// The field must not be set in both.
+func moveMetricSpecField(src map[string]any, from string, dst map[string]any, to string) error {
+	value, ok := src[from]
+	if !ok {
+		return nil
+	}
+	if _, exists := dst[to]; exists {
+		return fmt.Errorf("%s cannot be set along with '%s'", from, to)
+	}
+	delete(src, from)
+	dst[to] = value
+	return nil
+}
+
+// getMetricSpecObject returns a copy of the object held by the spec field,
+// or nil if the field is not set.
+func getMetricSpecObject(spec map[string]any, field string) (map[string]any, error) {
+	value, ok := spec[field]
+	if !ok {
+		return nil, nil
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object, got %T", field, value)
+	}
+	return maps.Clone(object), nil
+}
+
+// setMetricSpecObject sets the spec field to the object, empty objects are removed from the spec.
+func setMetricSpecObject(spec map[string]any, field string, object map[string]any) {
+	if len(object) == 0 {
+		delete(spec, field)
+		return
+	}
+	spec[field] = object
+}
@@ -0,0 +1,323 @@
+package openslotonobl9
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/nobl9/govy/pkg/jsonpath"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
+)
+
+// errCodeUnknownField is the code of errors reported for fields which Nobl9 does not define.
+const errCodeUnknownField govy.ErrorCode = "unknown_field"
+
+// metricSpecsToValidate lists the OpenSLO metrics of a single SLI which are validated together,
+// the same way Nobl9 validates its raw and count metrics.
+type metricSpecsToValidate struct {
+	// path of the SLI spec.
+	path      string
+	threshold *metricSpecToValidate
+	counter   bool
+	good      *metricSpecToValidate
+	bad       *metricSpecToValidate
+	total     *metricSpecToValidate
+}
+
+// metricSpecToValidate is a single OpenSLO metric spec.
+type metricSpecToValidate struct {
+	// path of the metric within the SLI spec.
+	path string
+	// specPath is the path of the metric spec relative to the metric.
+	specPath string
+	typ      string
+	spec     map[string]any
+}
+
+// validateMetricSpecs decodes v1 and v2alpha SLO metric specs into Nobl9 metric specs
+// and validates them with Nobl9 validation.
+// Nobl9 reports errors at the paths of its own SLO, they are reported at the OpenSLO metric spec paths instead.
+// Metrics of unknown types are not validated, their type is reported by [opensloObjectValidation].
+func validateMetricSpecs(object openslo.Object) govy.PropertyErrors {
+	var slis []metricSpecsToValidate
+	switch v := object.(type) {
+	case v1.SLO:
+		if v.Spec.Indicator != nil {
+			slis = append(slis, v1MetricSpecsToValidate("spec.indicator.spec", v.Spec.Indicator.Spec))
+		}
+	case v2alpha.SLO:
+		if v.Spec.SLI != nil {
+			slis = append(slis, v2alphaMetricSpecsToValidate("spec.sli.spec", v.Spec.SLI.Spec))
+		}
+		for i, objective := range v.Spec.Objectives {
+			if objective.SLI != nil {
+				slis = append(slis, v2alphaMetricSpecsToValidate(
+					fmt.Sprintf("spec.objectives[%d].sli.spec", i), objective.SLI.Spec))
+			}
+		}
+	}
+	var errs govy.PropertyErrors
+	for _, sli := range slis {
+		errs = append(errs, sli.validate()...)
+	}
+	return errs
+}
+
+func v1MetricSpecsToValidate(path string, spec v1.SLISpec) metricSpecsToValidate {
+	newMetric := func(metricPath string, metric *v1.SLIMetricSpec) *metricSpecToValidate {
+		if metric == nil {
+			return nil
+		}
+		return &metricSpecToValidate{
+			path:     metricPath,
+			specPath: "metricSource.spec",
+			typ:      metric.MetricSource.Type,
+			spec:     metric.MetricSource.Spec,
+		}
+	}
+	metrics := metricSpecsToValidate{
+		path:      path,
+		threshold: newMetric("thresholdMetric", spec.ThresholdMetric),
+	}
+	if ratio := spec.RatioMetric; ratio != nil {
+		metrics.counter = ratio.Counter
+		metrics.good = newMetric("ratioMetric.good", ratio.Good)
+		metrics.bad = newMetric("ratioMetric.bad", ratio.Bad)
+		metrics.total = newMetric("ratioMetric.total", ratio.Total)
+	}
+	return metrics
+}
+
+func v2alphaMetricSpecsToValidate(path string, spec v2alpha.SLISpec) metricSpecsToValidate {
+	newMetric := func(metricPath string, metric *v2alpha.SLIMetricSpec) *metricSpecToValidate {
+		if metric == nil || metric.DataSourceSpec == nil {
+			return nil
+		}
+		return &metricSpecToValidate{
+			path:     metricPath,
+			specPath: "spec",
+			typ:      metric.DataSourceSpec.Type,
+			spec:     metric.Spec,
+		}
+	}
+	metrics := metricSpecsToValidate{
+		path:      path,
+		threshold: newMetric("thresholdMetric", spec.ThresholdMetric),
+	}
+	if ratio := spec.RatioMetric; ratio != nil {
+		metrics.counter = ratio.Counter
+		metrics.good = newMetric("ratioMetric.good", ratio.Good)
+		metrics.bad = newMetric("ratioMetric.bad", ratio.Bad)
+		metrics.total = newMetric("ratioMetric.total", ratio.Total)
+	}
+	return metrics
+}
+
+// validate decodes the metric specs and validates them with Nobl9 raw or count metrics validation.
+// Count metrics are only validated if all of them were decoded.
+func (m metricSpecsToValidate) validate() govy.PropertyErrors {
+	var errs govy.PropertyErrors
+	decode := func(metric *metricSpecToValidate) (*slo.MetricSpec, bool) {
+		if metric == nil {
+			return nil, true
+		}
+		spec, decodeErrs := metric.decode(m.path)
+		errs = append(errs, decodeErrs...)
+		return spec, spec != nil
+	}
+
+	if spec, ok := decode(m.threshold); ok && spec != nil {
+		err := slo.RawMetricsValidation.Validate(slo.RawMetricSpec{MetricQuery: spec})
+		errs = append(errs, m.mapNobl9Errors(err, m.threshold.path,
+			map[string]*metricSpecToValidate{"query": m.threshold})...)
+	}
+	good, goodOK := decode(m.good)
+	bad, badOK := decode(m.bad)
+	total, totalOK := decode(m.total)
+	if goodOK && badOK && totalOK && (good != nil || bad != nil || total != nil) {
+		err := slo.CountMetricsSpecValidation.Validate(slo.CountMetricsSpec{
+			Incremental: &m.counter,
+			GoodMetric:  good,
+			BadMetric:   bad,
+			TotalMetric: total,
+		})
+		errs = append(errs, m.mapNobl9Errors(err, "ratioMetric", map[string]*metricSpecToValidate{
+			"good":  m.good,
+			"bad":   m.bad,
+			"total": m.total,
+		})...)
+	}
+	return errs
+}
+
+// mapNobl9Errors maps the paths of Nobl9 validation errors to the OpenSLO paths.
+// Nobl9 paths start with the metric name followed by the metric type, for instance 'good.prometheus.promql',
+// which is mapped to the spec path of the matching OpenSLO metric.
+// Errors which are not specific to a metric are reported at the given path of the SLI spec.
+func (m metricSpecsToValidate) mapNobl9Errors(
+	err error,
+	path string,
+	metrics map[string]*metricSpecToValidate,
+) govy.PropertyErrors {
+	var validatorErr *govy.ValidatorError
+	if !errors.As(err, &validatorErr) {
+		if err == nil {
+			return nil
+		}
+		return govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(joinPath(m.path, path)), nil, err)}
+	}
+	errs := make(govy.PropertyErrors, 0, len(validatorErr.Errors))
+	for _, propertyErr := range validatorErr.Errors {
+		mapped := *propertyErr
+		nobl9Path := propertyErr.PropertyPath.String()
+		name, rest, _ := strings.Cut(nobl9Path, ".")
+		metric := metrics[name]
+		switch {
+		case metric != nil:
+			rest = strings.TrimPrefix(strings.TrimPrefix(rest, metric.typ), ".")
+			mapped.PropertyPath = jsonpath.Parse(joinPath(m.path, metric.path, metric.specPath, rest))
+		default:
+			mapped.PropertyPath = jsonpath.Parse(joinPath(m.path, path, nobl9Path))
+		}
+		errs = append(errs, &mapped)
+	}
+	return errs
+}
+
+// decode translates the OpenSLO metric spec and decodes it into the Nobl9 metric spec.
+// Metrics of unknown types are not decoded.
+func (m metricSpecToValidate) decode(sliPath string) (*slo.MetricSpec, govy.PropertyErrors) {
+	if _, ok := jsonFieldTypes(reflect.TypeFor[slo.MetricSpec]())[m.typ]; !ok {
+		return nil, nil
+	}
+	path := joinPath(sliPath, m.path, m.specPath)
+	spec, err := translateMetricSpec(m.typ, m.spec)
+	if err != nil {
+		return nil, govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(path), nil, err)}
+	}
+	return decodeNobl9Spec[slo.MetricSpec](m.typ, spec, path, fmt.Sprintf("'%s' metric spec", m.typ))
+}
+
+// decodeNobl9Spec decodes the value into the field of the Nobl9 spec T named after the type,
+// for instance 'prometheus' field of [slo.MetricSpec].
+// Unknown fields and values which cannot be decoded are reported as errors at the given path,
+// in which case nil spec is returned.
+// The name describes the decoded value in unknown field errors.
+func decodeNobl9Spec[T any](typ string, value any, path, name string) (*T, govy.PropertyErrors) {
+	fieldType, ok := jsonFieldTypes(reflect.TypeFor[T]())[typ]
+	if !ok {
+		return nil, nil
+	}
+	var errs govy.PropertyErrors
+	for _, unknown := range findUnknownFields(value, fieldType, "") {
+		errs = append(errs, govy.NewPropertyError(jsonpath.Parse(joinPath(path, unknown)), nil,
+			govy.NewRuleError(fmt.Sprintf("field is not defined by Nobl9 %s", name), errCodeUnknownField)))
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	data, err := json.Marshal(map[string]any{typ: value})
+	if err != nil {
+		return nil, govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(path), nil, err)}
+	}
+	var spec T
+	if err = json.Unmarshal(data, &spec); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			field := strings.TrimPrefix(strings.TrimPrefix(typeErr.Field, typ), ".")
+			return nil, govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(joinPath(path, field)), nil,
+				govy.NewRuleError(fmt.Sprintf("%s cannot be decoded into %s",
+					typeErr.Value, strings.TrimLeft(typeErr.Type.String(), "*"))))}
+		}
+		return nil, govy.PropertyErrors{govy.NewPropertyError(jsonpath.Parse(path), nil, err)}
+	}
+	return &spec, nil
+}
+
+// findUnknownFields returns sorted paths of the object fields which are not defined by the struct type.
+// Slices and maps are checked element by element, other types are not checked.
+func findUnknownFields(value any, typ reflect.Type, path string) []string {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	var unknown []string
+	switch v := value.(type) {
+	case map[string]any:
+		switch typ.Kind() {
+		case reflect.Struct:
+			fields := jsonFieldTypes(typ)
+			for key, fieldValue := range v {
+				fieldType, ok := fields[key]
+				if !ok {
+					unknown = append(unknown, joinPath(path, key))
+					continue
+				}
+				unknown = append(unknown, findUnknownFields(fieldValue, fieldType, joinPath(path, key))...)
+			}
+		case reflect.Map:
+			for key, fieldValue := range v {
+				unknown = append(unknown, findUnknownFields(fieldValue, typ.Elem(), joinPath(path, key))...)
+			}
+		}
+	case []any:
+		if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+			for i, element := range v {
+				unknown = append(unknown, findUnknownFields(element, typ.Elem(), path+"["+strconv.Itoa(i)+"]")...)
+			}
+		}
+	}
+	slices.Sort(unknown)
+	return unknown
+}
+
+// jsonFieldTypes returns the types of the struct fields keyed by their JSON names,
+// including the fields of embedded structs.
+func jsonFieldTypes(typ reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, typ.NumField())
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if name == "" && field.Anonymous && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFieldTypes(fieldType) {
+				fields[embeddedName] = embeddedType
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// joinPath joins non-empty path elements with dots, elements which start with an index are not separated.
+func joinPath(elements ...string) string {
+	var b strings.Builder
+	for _, element := range elements {
+		if element == "" {
+			continue
+		}
+		if b.Len() > 0 && !strings.HasPrefix(element, "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(element)
+	}
+	return b.String()
+}
@@ -0,0 +1,209 @@
+package openslotonobl9
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+// metricTypeAliases maps common OpenSLO metric source type spellings which don't match
+// Nobl9 metric types once normalized with [metricTypeKey] to Nobl9 metric types.
+var metricTypeAliases = map[string]string{
+	"amp":                     "amazonPrometheus",
+	"awsprometheus":           "amazonPrometheus",
+	"amazonmanagedprometheus": "amazonPrometheus",
+	"appd":                    "appDynamics",
+	"awscloudwatch":           "cloudWatch",
+	"amazoncloudwatch":        "cloudWatch",
+	"azuremanagedprometheus":  "azurePrometheus",
+	"googlebigquery":          "bigQuery",
+	"gcpbigquery":             "bigQuery",
+	"elastic":                 "elasticsearch",
+	"googlecloudmonitoring":   "gcm",
+	"gcpmonitoring":           "gcm",
+	"stackdriver":             "gcm",
+	"loki":                    "grafanaLoki",
+	"influx":                  "influxdb",
+	"amazonredshift":          "redshift",
+	"awsredshift":             "redshift",
+	"signalfx":                "splunkObservability",
+	"sumo":                    "sumoLogic",
+}
+
+// metricTypes maps every Nobl9 metric type and built-in alias, normalized with [metricTypeKey],
+// to the Nobl9 metric type.
+var metricTypes = func() map[string]string {
+	types := make(map[string]string, len(metricTypeAliases))
+	for _, name := range getMetricSpecTypeNames() {
+		types[metricTypeKey(name)] = name
+	}
+	maps.Copy(types, metricTypeAliases)
+	return types
+}()
+
+// metricTypeKey normalizes the metric type spelling, the case and
+// '-', '_', '.' and ' ' separators are ignored.
+func metricTypeKey(typ string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(typ))
+}
+
+// normalizeMetricType returns the Nobl9 metric type for the OpenSLO metric source type.
+// Aliases set with [WithMetricTypeAliases] take precedence over the built-in ones.
+// Unknown types are returned as is, they are reported by the validation.
+func (c *Converter) normalizeMetricType(typ string) string {
+	key := metricTypeKey(typ)
+	if normalized, ok := c.metricTypeAliases[key]; ok {
+		return normalized
+	}
+	if normalized, ok := metricTypes[key]; ok {
+		return normalized
+	}
+	return typ
+}
+
+// normalizeMetricTypes returns the objects with their metric source and DataSource types
+// replaced by Nobl9 metric types.
+// The original type is recorded as 'openslo.com/<path>' annotation, which is preserved by the conversion.
+// v2alpha SLO metrics take their type from the DataSource which records it instead,
+// v1alpha objects have no annotations and their original types are not recorded.
+// The input objects are never mutated.
+func (c *Converter) normalizeMetricTypes(objects []openslo.Object) []openslo.Object {
+	result := make([]openslo.Object, 0, len(objects))
+	for _, object := range objects {
+		switch v := object.(type) {
+		case v1.SLO:
+			object = c.normalizeV1SLOMetricTypes(v)
+		case v1.DataSource:
+			v.Spec.Type, v.Metadata.Annotations = c.normalizeObjectType(
+				v.Spec.Type, "spec.type", v.Metadata.Annotations)
+			object = v
+		case v1alpha.SLO:
+			object = c.normalizeV1alphaSLOMetricTypes(v)
+		case v2alpha.SLO:
+			object = c.normalizeV2alphaSLOMetricTypes(v)
+		case v2alpha.DataSource:
+			v.Spec.Type, v.Metadata.Annotations = c.normalizeObjectType(
+				v.Spec.Type, "spec.type", v.Metadata.Annotations)
+			object = v
+		}
+		result = append(result, object)
+	}
+	return result
+}
+
+// normalizeObjectType returns the Nobl9 metric type and the annotations with the original type
+// recorded at the given path, if it differs.
+// The annotations are cloned before they're modified.
+func (c *Converter) normalizeObjectType(
+	typ, path string,
+	annotations map[string]string,
+) (string, map[string]string) {
+	normalized := c.normalizeMetricType(typ)
+	if normalized == typ {
+		return typ, annotations
+	}
+	annotations = maps.Clone(annotations)
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[DomainOpenSLO+"/"+path] = typ
+	return normalized, annotations
+}
+
+func (c *Converter) normalizeV1SLOMetricTypes(slo v1.SLO) v1.SLO {
+	if slo.Spec.Indicator == nil {
+		return slo
+	}
+	indicator := *slo.Spec.Indicator
+	path := "spec.indicator.spec"
+	normalize := func(metricPath string, metric *v1.SLIMetricSpec) *v1.SLIMetricSpec {
+		if metric == nil {
+			return nil
+		}
+		normalized := *metric
+		normalized.MetricSource.Type, slo.Metadata.Annotations = c.normalizeObjectType(
+			metric.MetricSource.Type, path+metricPath+".metricSource.type", slo.Metadata.Annotations)
+		return &normalized
+	}
+	indicator.Spec.ThresholdMetric = normalize(".thresholdMetric", indicator.Spec.ThresholdMetric)
+	if indicator.Spec.RatioMetric != nil {
+		ratio := *indicator.Spec.RatioMetric
+		ratio.Good = normalize(".ratioMetric.good", ratio.Good)
+		ratio.Bad = normalize(".ratioMetric.bad", ratio.Bad)
+		ratio.Total = normalize(".ratioMetric.total", ratio.Total)
+		ratio.Raw = normalize(".ratioMetric.raw", ratio.Raw)
+		indicator.Spec.RatioMetric = &ratio
+	}
+	slo.Spec.Indicator = &indicator
+	return slo
+}
+
+func (c *Converter) normalizeV1alphaSLOMetricTypes(slo v1alpha.SLO) v1alpha.SLO {
+	if slo.Spec.Indicator != nil {
+		indicator := *slo.Spec.Indicator
+		indicator.ThresholdMetric.Source = c.normalizeMetricType(indicator.ThresholdMetric.Source)
+		slo.Spec.Indicator = &indicator
+	}
+	slo.Spec.Objectives = slices.Clone(slo.Spec.Objectives)
+	for i, objective := range slo.Spec.Objectives {
+		if objective.RatioMetrics == nil {
+			continue
+		}
+		ratio := *objective.RatioMetrics
+		ratio.Good.Source = c.normalizeMetricType(ratio.Good.Source)
+		ratio.Total.Source = c.normalizeMetricType(ratio.Total.Source)
+		slo.Spec.Objectives[i].RatioMetrics = &ratio
+	}
+	return slo
+}
+
+func (c *Converter) normalizeV2alphaSLOMetricTypes(slo v2alpha.SLO) v2alpha.SLO {
+	normalizeSLI := func(sli *v2alpha.SLOSLIInline) *v2alpha.SLOSLIInline {
+		if sli == nil {
+			return nil
+		}
+		normalized := *sli
+		normalized.Spec = c.normalizeV2alphaSLIMetricTypes(sli.Spec)
+		return &normalized
+	}
+	slo.Spec.SLI = normalizeSLI(slo.Spec.SLI)
+	slo.Spec.Objectives = slices.Clone(slo.Spec.Objectives)
+	for i, objective := range slo.Spec.Objectives {
+		slo.Spec.Objectives[i].SLI = normalizeSLI(objective.SLI)
+	}
+	return slo
+}
+
+func (c *Converter) normalizeV2alphaSLIMetricTypes(spec v2alpha.SLISpec) v2alpha.SLISpec {
+	normalize := func(metric *v2alpha.SLIMetricSpec) *v2alpha.SLIMetricSpec {
+		if metric == nil || metric.DataSourceSpec == nil {
+			return metric
+		}
+		normalized := *metric
+		dataSourceSpec := *metric.DataSourceSpec
+		dataSourceSpec.Type = c.normalizeMetricType(dataSourceSpec.Type)
+		normalized.DataSourceSpec = &dataSourceSpec
+		return &normalized
+	}
+	spec.ThresholdMetric = normalize(spec.ThresholdMetric)
+	if spec.RatioMetric != nil {
+		ratio := *spec.RatioMetric
+		ratio.Good = normalize(ratio.Good)
+		ratio.Bad = normalize(ratio.Bad)
+		ratio.Total = normalize(ratio.Total)
+		ratio.Raw = normalize(ratio.Raw)
+		spec.RatioMetric = &ratio
+	}
+	return spec
+}
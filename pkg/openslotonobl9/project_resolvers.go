@@ -0,0 +1,283 @@
+package openslotonobl9
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+)
+
+const projectAnnotation = DomainNobl9 + "/metadata.project"
+
+// ProjectResolver returns the Nobl9 project of the OpenSLO object,
+// or an empty string if it cannot determine one.
+// The lookup returns the project resolved for other converted objects,
+// which allows deriving the project from the objects the object references.
+type ProjectResolver func(object openslo.Object, lookup ProjectLookup) string
+
+// ProjectLookup returns the project resolved for the converted object of the given kind and name,
+// or an empty string if there's no such object or its project could not be resolved.
+type ProjectLookup func(kind openslo.Kind, name string) string
+
+// ProjectFromLabel resolves the project from the value of the object label with the given key.
+// v1 labels can have multiple values, the first one is used.
+// v1alpha objects have no labels.
+func ProjectFromLabel(key string) ProjectResolver {
+	return func(object openslo.Object, _ ProjectLookup) string {
+		return getObjectMetadata(object).label(key)
+	}
+}
+
+// ProjectFromService resolves the project of an SLO from the Service referenced by its
+// 'spec.service' ('spec.serviceRef' for v2alpha).
+// The Service must be a part of the converted objects.
+func ProjectFromService() ProjectResolver {
+	return func(object openslo.Object, lookup ProjectLookup) string {
+		var service string
+		switch v := object.(type) {
+		case v1alpha.SLO:
+			service = v.Spec.Service
+		case v1.SLO:
+			service = v.Spec.Service
+		case v2alpha.SLO:
+			service = v.Spec.ServiceRef
+		default:
+			return ""
+		}
+		return lookup(openslo.KindService, service)
+	}
+}
+
+// ProjectFromSourceDirectory resolves the project from the name of the directory
+// which contains the file the object was loaded from.
+// If the object was loaded from multiple files, the first one is used.
+func ProjectFromSourceDirectory(sources ObjectSources) ProjectResolver {
+	return func(object openslo.Object, _ ProjectLookup) string {
+		files := sources.Files(object)
+		if len(files) == 0 {
+			return ""
+		}
+		dir := filepath.Base(filepath.Dir(files[0]))
+		if dir == "." || dir == string(filepath.Separator) {
+			return ""
+		}
+		return dir
+	}
+}
+
+// StaticProject resolves the same project for every object.
+// Resolvers which follow it are never used, it is meant to be the last resolver.
+func StaticProject(project string) ProjectResolver {
+	return func(openslo.Object, ProjectLookup) string { return project }
+}
+
+// objectProjects holds the projects resolved for the converted objects which don't define
+// 'nobl9.com/metadata.project' annotation, keyed by [objectKey].
+// Objects whose project could not be resolved are assigned the default project.
+type objectProjects map[string]string
+
+// get returns the project of the object or an empty string if it was not resolved.
+// The annotation is checked first, objects of the same kind and name can be defined for different projects.
+func (p objectProjects) get(object openslo.Object) string {
+	if project := getObjectMetadata(object).annotations[projectAnnotation]; project != "" {
+		return project
+	}
+	return p[objectKey(object.GetKind(), object.GetName())]
+}
+
+// projectResolution resolves the projects of the converted objects.
+type projectResolution struct {
+	objects        map[string]openslo.Object
+	resolvers      []ProjectResolver
+	defaultProject string
+	// users of AlertPolicy and AlertNotificationTarget objects, keyed by [objectKey].
+	users     map[string][]openslo.Object
+	projects  objectProjects
+	resolving map[string]bool
+	err       error
+}
+
+// resolveProjects resolves the project of every object.
+// The project is taken from the first of:
+//
+//  1. 'nobl9.com/metadata.project' annotation.
+//  2. The project of the SLOs which use the AlertPolicy, or the AlertPolicies which use the AlertNotificationTarget.
+//  3. The first [ProjectResolver] which returns a project.
+//
+// Nobl9 SLOs can only use the AlertPolicies from their own project,
+// if an AlertPolicy is used by SLOs from different projects an error is returned.
+// AlertNotificationTargets used by AlertPolicies from different projects
+// are resolved as if they were not used by any.
+func (c *Converter) resolveProjects(objects []openslo.Object) (objectProjects, error) {
+	r := &projectResolution{
+		objects:        make(map[string]openslo.Object, len(objects)),
+		resolvers:      c.projectResolvers,
+		defaultProject: c.defaultProject,
+		users:          make(map[string][]openslo.Object),
+		projects:       make(objectProjects, len(objects)),
+		resolving:      make(map[string]bool),
+	}
+	for _, object := range objects {
+		if key := objectKey(object.GetKind(), object.GetName()); r.objects[key] == nil {
+			r.objects[key] = object
+		}
+		for _, ref := range getUsedObjects(object) {
+			r.users[ref] = append(r.users[ref], object)
+		}
+	}
+	for _, object := range objects {
+		r.resolve(object)
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	return r.projects, nil
+}
+
+func (r *projectResolution) lookup(kind openslo.Kind, name string) string {
+	object, ok := r.objects[objectKey(kind, name)]
+	if !ok {
+		return ""
+	}
+	return r.resolve(object)
+}
+
+func (r *projectResolution) resolve(object openslo.Object) string {
+	if project := getObjectMetadata(object).annotations[projectAnnotation]; project != "" {
+		return project
+	}
+	key := objectKey(object.GetKind(), object.GetName())
+	if project, ok := r.projects[key]; ok {
+		return project
+	}
+	// Break reference cycles, the object is not resolved from the objects which are resolved from it.
+	if r.resolving[key] || r.err != nil {
+		return ""
+	}
+	r.resolving[key] = true
+	defer delete(r.resolving, key)
+
+	project := r.inherit(object)
+	for _, resolver := range r.resolvers {
+		if project != "" {
+			break
+		}
+		project = resolver(object, r.lookup)
+	}
+	if project != "" {
+		r.projects[key] = project
+	}
+	return project
+}
+
+// inherit returns the project shared by all the objects which use the object.
+// Users whose project could not be resolved are assigned the default project.
+func (r *projectResolution) inherit(object openslo.Object) string {
+	var (
+		projects []string
+		resolved bool
+	)
+	for _, user := range r.users[objectKey(object.GetKind(), object.GetName())] {
+		project := r.resolve(user)
+		resolved = resolved || project != ""
+		projects = append(projects, cmp.Or(project, r.defaultProject))
+	}
+	slices.Sort(projects)
+	projects = slices.Compact(projects)
+	switch {
+	case len(projects) == 1 && resolved:
+		return projects[0]
+	case len(projects) > 1 && object.GetKind() == openslo.KindAlertPolicy && r.err == nil:
+		r.err = newObjectConversionError(object, "", fmt.Errorf(
+			"AlertPolicy is used by SLOs from different projects: %s, Nobl9 SLOs can only use AlertPolicies"+
+				" from their own project, define separate AlertPolicies for each project",
+			strings.Join(projects, ", ")))
+	}
+	return ""
+}
+
+// getUsedObjects returns the keys of AlertPolicy and AlertNotificationTarget objects
+// the object references.
+func getUsedObjects(object openslo.Object) []string {
+	var keys []string
+	switch v := object.(type) {
+	case v1.SLO:
+		for _, alertPolicy := range v.Spec.AlertPolicies {
+			if alertPolicy.SLOAlertPolicyRef != nil {
+				keys = append(keys, objectKey(openslo.KindAlertPolicy, alertPolicy.AlertPolicyRef))
+			}
+		}
+	case v2alpha.SLO:
+		for _, alertPolicy := range v.Spec.AlertPolicies {
+			if alertPolicy.SLOAlertPolicyRef != nil {
+				keys = append(keys, objectKey(openslo.KindAlertPolicy, alertPolicy.AlertPolicyRef))
+			}
+		}
+	case v1.AlertPolicy:
+		for _, target := range v.Spec.NotificationTargets {
+			if target.AlertPolicyNotificationTargetRef != nil {
+				keys = append(keys, objectKey(openslo.KindAlertNotificationTarget, target.TargetRef))
+			}
+		}
+	case v2alpha.AlertPolicy:
+		for _, target := range v.Spec.NotificationTargets {
+			if target.AlertPolicyNotificationTargetRef != nil {
+				keys = append(keys, objectKey(openslo.KindAlertNotificationTarget, target.TargetRef))
+			}
+		}
+	}
+	return keys
+}
+
+// objectMetadata holds the metadata fields shared by OpenSLO objects of all versions.
+type objectMetadata struct {
+	labels      map[string]json.RawMessage
+	annotations map[string]string
+}
+
+// getObjectMetadata returns the labels and annotations of the object.
+// If the object cannot be encoded, empty metadata is returned, encoding errors are reported
+// once the object is converted.
+func getObjectMetadata(object openslo.Object) objectMetadata {
+	var decoded struct {
+		Metadata struct {
+			Labels      map[string]json.RawMessage `json:"labels"`
+			Annotations map[string]string          `json:"annotations"`
+		} `json:"metadata"`
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		return objectMetadata{}
+	}
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		return objectMetadata{}
+	}
+	return objectMetadata{
+		labels:      decoded.Metadata.Labels,
+		annotations: decoded.Metadata.Annotations,
+	}
+}
+
+// label returns the value of the label, v1 labels are lists of values, the first one is returned.
+func (m objectMetadata) label(key string) string {
+	raw, ok := m.labels[key]
+	if !ok {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil && len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
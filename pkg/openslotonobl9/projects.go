@@ -0,0 +1,121 @@
+package openslotonobl9
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/alertpolicy"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/project"
+	"github.com/nobl9/nobl9-go/manifest/v1alpha/slo"
+)
+
+const (
+	projectDisplayNameAnnotation = "project." + DomainNobl9 + "/display-name"
+	projectDescriptionAnnotation = "project." + DomainNobl9 + "/description"
+)
+
+// ProjectDetails describes a Nobl9 project generated with [WithProjectGeneration].
+type ProjectDetails struct {
+	DisplayName string
+	Description string
+}
+
+// newProjects returns Nobl9 Project objects for every project referenced by the Nobl9 objects.
+// The details provided with [WithProjectGeneration] take precedence over the details
+// defined by Service annotations.
+func (c *Converter) newProjects(
+	opensloObjects []openslo.Object,
+	projects objectProjects,
+	nobl9Objects []manifest.Object,
+) ([]manifest.Object, error) {
+	details, err := c.getServiceProjectDetails(opensloObjects, projects)
+	if err != nil {
+		return nil, err
+	}
+	for name, d := range c.projectDetails {
+		details[name] = ProjectDetails{
+			DisplayName: cmp.Or(d.DisplayName, details[name].DisplayName),
+			Description: cmp.Or(d.Description, details[name].Description),
+		}
+	}
+	names := getReferencedProjects(nobl9Objects)
+	result := make([]manifest.Object, 0, len(names))
+	for _, name := range names {
+		result = append(result, project.New(
+			project.Metadata{Name: name, DisplayName: details[name].DisplayName},
+			project.Spec{Description: details[name].Description},
+		))
+	}
+	return result, nil
+}
+
+// getServiceProjectDetails returns the project details defined by
+// 'project.nobl9.com/display-name' and 'project.nobl9.com/description' Service annotations.
+// Services of the same project must not define different details.
+func (c *Converter) getServiceProjectDetails(
+	objects []openslo.Object,
+	projects objectProjects,
+) (map[string]ProjectDetails, error) {
+	details := make(map[string]ProjectDetails)
+	for _, object := range objects {
+		var annotations map[string]string
+		switch v := object.(type) {
+		case v1.Service:
+			annotations = v.Metadata.Annotations
+		case v2alpha.Service:
+			annotations = v.Metadata.Annotations
+		default:
+			continue
+		}
+		name := cmp.Or(projects.get(object), c.defaultProject)
+		current := details[name]
+		for _, annotation := range []struct {
+			key   string
+			field *string
+		}{
+			{key: projectDisplayNameAnnotation, field: &current.DisplayName},
+			{key: projectDescriptionAnnotation, field: &current.Description},
+		} {
+			key, field := annotation.key, annotation.field
+			value := annotations[key]
+			if value == "" {
+				continue
+			}
+			if *field != "" && *field != value {
+				return nil, newObjectConversionError(object, "metadata.annotations."+key, fmt.Errorf(
+					"project '%s' is already defined with '%s' value '%s' by another Service", name, key, *field))
+			}
+			*field = value
+		}
+		details[name] = current
+	}
+	return details, nil
+}
+
+// getReferencedProjects returns sorted names of the projects which the objects belong to or reference.
+func getReferencedProjects(objects []manifest.Object) []string {
+	var names []string
+	for _, object := range objects {
+		if v, ok := object.(manifest.ProjectScopedObject); ok {
+			names = append(names, v.GetProject())
+		}
+		switch v := object.(type) {
+		case slo.SLO:
+			if v.Spec.Indicator != nil {
+				names = append(names, v.Spec.Indicator.MetricSource.Project)
+			}
+		case alertpolicy.AlertPolicy:
+			for _, alertMethod := range v.Spec.AlertMethods {
+				names = append(names, alertMethod.Metadata.Project)
+			}
+		}
+	}
+	names = slices.DeleteFunc(names, func(name string) bool { return name == "" })
+	slices.Sort(names)
+	return slices.Compact(names)
+}
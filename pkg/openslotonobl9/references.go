@@ -2,8 +2,10 @@ package openslotonobl9
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
 )
 
@@ -42,5 +44,222 @@ func resolveObjectReferences(objects []openslo.Object) ([]openslo.Object, error)
 	objects = openslosdk.NewReferenceExporter(objects...).
 		WithConfig(opensloExportReferenceConfig).
 		Export()
+
+	objects, err = newV2alphaReferenceResolver(objects).Resolve()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve OpenSLO v2alpha references: %w", err)
+	}
 	return objects, nil
 }
+
+// v2alphaReferenceResolver applies the same reference resolution strategy to v2alpha objects
+// as the OpenSLO SDK does for v1 objects, the SDK does not support v2alpha references.
+//
+// It inlines referenced SLI and AlertCondition objects and removes them from the result,
+// exports inlined AlertPolicy and AlertNotificationTarget objects and fills in
+// dataSourceSpec for every SLI metric which defines dataSourceRef.
+// The input objects are never mutated.
+type v2alphaReferenceResolver struct {
+	objects         []openslo.Object
+	slis            map[string]v2alpha.SLI
+	alertConditions map[string]v2alpha.AlertCondition
+	dataSources     map[string]v2alpha.DataSource
+	referenced      map[string]bool
+	exported        []openslo.Object
+	exportedNames   map[string]bool
+}
+
+func newV2alphaReferenceResolver(objects []openslo.Object) *v2alphaReferenceResolver {
+	r := &v2alphaReferenceResolver{
+		objects:         objects,
+		slis:            make(map[string]v2alpha.SLI),
+		alertConditions: make(map[string]v2alpha.AlertCondition),
+		dataSources:     make(map[string]v2alpha.DataSource),
+		referenced:      make(map[string]bool),
+		exportedNames:   make(map[string]bool),
+	}
+	for _, object := range objects {
+		switch v := object.(type) {
+		case v2alpha.SLI:
+			r.slis[v.Metadata.Name] = v
+		case v2alpha.AlertCondition:
+			r.alertConditions[v.Metadata.Name] = v
+		case v2alpha.DataSource:
+			r.dataSources[v.Metadata.Name] = v
+		}
+		if object.GetVersion() == openslo.VersionV2alpha {
+			r.exportedNames[objectKey(object.GetKind(), object.GetName())] = true
+		}
+	}
+	return r
+}
+
+// Resolve returns the objects with their v2alpha references resolved.
+func (r *v2alphaReferenceResolver) Resolve() ([]openslo.Object, error) {
+	resolved := make([]openslo.Object, 0, len(r.objects))
+	for _, object := range r.objects {
+		var err error
+		switch v := object.(type) {
+		case v2alpha.SLO:
+			object, err = r.resolveSLO(v)
+		case v2alpha.AlertPolicy:
+			object, err = r.resolveAlertPolicy(v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve references for %s %s: %w",
+				object.GetKind(), object.GetName(), err)
+		}
+		resolved = append(resolved, object)
+	}
+	resolved = slices.DeleteFunc(resolved, func(o openslo.Object) bool {
+		return o.GetVersion() == openslo.VersionV2alpha && r.referenced[objectKey(o.GetKind(), o.GetName())]
+	})
+	return append(resolved, r.exported...), nil
+}
+
+func (r *v2alphaReferenceResolver) resolveSLO(slo v2alpha.SLO) (v2alpha.SLO, error) {
+	var err error
+	slo.Spec.SLI, slo.Spec.SLIRef, err = r.resolveSLOSLI(slo.Spec.SLI, slo.Spec.SLIRef)
+	if err != nil {
+		return slo, err
+	}
+	slo.Spec.Objectives = slices.Clone(slo.Spec.Objectives)
+	for i, objective := range slo.Spec.Objectives {
+		if objective.SLI == nil && objective.SLIRef == nil {
+			continue
+		}
+		objective.SLI, objective.SLIRef, err = r.resolveSLOSLI(objective.SLI, objective.SLIRef)
+		if err != nil {
+			return slo, err
+		}
+		slo.Spec.Objectives[i] = objective
+	}
+	slo.Spec.AlertPolicies = slices.Clone(slo.Spec.AlertPolicies)
+	for i, alertPolicy := range slo.Spec.AlertPolicies {
+		if alertPolicy.SLOAlertPolicyInline == nil {
+			continue
+		}
+		exported, err := r.resolveAlertPolicy(v2alpha.NewAlertPolicy(
+			alertPolicy.SLOAlertPolicyInline.Metadata,
+			alertPolicy.SLOAlertPolicyInline.Spec,
+		))
+		if err != nil {
+			return slo, err
+		}
+		r.export(exported)
+		slo.Spec.AlertPolicies[i] = v2alpha.SLOAlertPolicy{
+			SLOAlertPolicyRef: &v2alpha.SLOAlertPolicyRef{AlertPolicyRef: exported.Metadata.Name},
+		}
+	}
+	return slo, nil
+}
+
+func (r *v2alphaReferenceResolver) resolveSLOSLI(
+	sli *v2alpha.SLOSLIInline,
+	sliRef *string,
+) (*v2alpha.SLOSLIInline, *string, error) {
+	if sliRef != nil {
+		referenced, ok := r.slis[*sliRef]
+		if !ok {
+			return nil, nil, fmt.Errorf("referenced %s '%s' does not exist", openslo.KindSLI, *sliRef)
+		}
+		r.referenced[objectKey(openslo.KindSLI, *sliRef)] = true
+		sli = &v2alpha.SLOSLIInline{Metadata: referenced.Metadata, Spec: referenced.Spec}
+	}
+	if sli == nil {
+		return nil, nil, nil
+	}
+	spec, err := r.resolveSLISpec(sli.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &v2alpha.SLOSLIInline{Metadata: sli.Metadata, Spec: spec}, nil, nil
+}
+
+func (r *v2alphaReferenceResolver) resolveSLISpec(spec v2alpha.SLISpec) (v2alpha.SLISpec, error) {
+	var err error
+	if spec.ThresholdMetric, err = r.resolveSLIMetricSpec(spec.ThresholdMetric); err != nil {
+		return spec, err
+	}
+	if spec.RatioMetric == nil {
+		return spec, nil
+	}
+	ratioMetric := *spec.RatioMetric
+	for _, metric := range []**v2alpha.SLIMetricSpec{
+		&ratioMetric.Good,
+		&ratioMetric.Bad,
+		&ratioMetric.Total,
+		&ratioMetric.Raw,
+	} {
+		if *metric, err = r.resolveSLIMetricSpec(*metric); err != nil {
+			return spec, err
+		}
+	}
+	spec.RatioMetric = &ratioMetric
+	return spec, nil
+}
+
+// resolveSLIMetricSpec fills in the dataSourceSpec based on the referenced DataSource.
+// The dataSourceRef is kept as it becomes the Nobl9 metric source name.
+func (r *v2alphaReferenceResolver) resolveSLIMetricSpec(metric *v2alpha.SLIMetricSpec) (*v2alpha.SLIMetricSpec, error) {
+	if metric == nil || metric.DataSourceRef == "" {
+		return metric, nil
+	}
+	dataSource, ok := r.dataSources[metric.DataSourceRef]
+	if !ok {
+		return nil, fmt.Errorf("referenced %s '%s' does not exist", openslo.KindDataSource, metric.DataSourceRef)
+	}
+	resolved := *metric
+	resolved.DataSourceSpec = &dataSource.Spec
+	return &resolved, nil
+}
+
+func (r *v2alphaReferenceResolver) resolveAlertPolicy(alertPolicy v2alpha.AlertPolicy) (v2alpha.AlertPolicy, error) {
+	alertPolicy.Spec.Conditions = slices.Clone(alertPolicy.Spec.Conditions)
+	for i, condition := range alertPolicy.Spec.Conditions {
+		if condition.AlertPolicyConditionRef == nil {
+			continue
+		}
+		ref := condition.AlertPolicyConditionRef.ConditionRef
+		referenced, ok := r.alertConditions[ref]
+		if !ok {
+			return alertPolicy, fmt.Errorf("referenced %s '%s' does not exist", openslo.KindAlertCondition, ref)
+		}
+		r.referenced[objectKey(openslo.KindAlertCondition, ref)] = true
+		alertPolicy.Spec.Conditions[i] = v2alpha.AlertPolicyCondition{
+			AlertPolicyConditionInline: &v2alpha.AlertPolicyConditionInline{
+				Kind:     openslo.KindAlertCondition,
+				Metadata: referenced.Metadata,
+				Spec:     referenced.Spec,
+			},
+		}
+	}
+	alertPolicy.Spec.NotificationTargets = slices.Clone(alertPolicy.Spec.NotificationTargets)
+	for i, target := range alertPolicy.Spec.NotificationTargets {
+		if target.AlertPolicyNotificationTargetInline == nil {
+			continue
+		}
+		inline := target.AlertPolicyNotificationTargetInline
+		r.export(v2alpha.NewAlertNotificationTarget(inline.Metadata, inline.Spec))
+		alertPolicy.Spec.NotificationTargets[i] = v2alpha.AlertPolicyNotificationTarget{
+			AlertPolicyNotificationTargetRef: &v2alpha.AlertPolicyNotificationTargetRef{
+				TargetRef: inline.Metadata.Name,
+			},
+		}
+	}
+	return alertPolicy, nil
+}
+
+// export adds the object to the result unless an object with the same kind and name is already there.
+func (r *v2alphaReferenceResolver) export(object openslo.Object) {
+	key := objectKey(object.GetKind(), object.GetName())
+	if r.exportedNames[key] {
+		return
+	}
+	r.exportedNames[key] = true
+	r.exported = append(r.exported, object)
+}
+
+func objectKey(kind openslo.Kind, name string) string {
+	return kind.String() + "/" + name
+}
@@ -0,0 +1,56 @@
+package openslotonobl9
+
+import (
+	"fmt"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+)
+
+// WarningCode is a machine-readable identifier of a [Warning].
+type WarningCode string
+
+const (
+	// WarningCodeSkippedKind is reported when an object was not converted.
+	WarningCodeSkippedKind WarningCode = "skipped-kind"
+	// WarningCodeDroppedField is reported when a field has no Nobl9 counterpart and was lost.
+	WarningCodeDroppedField WarningCode = "dropped-field"
+	// WarningCodeLossyMapping is reported when a field was converted, but its meaning was not fully preserved.
+	WarningCodeLossyMapping WarningCode = "lossy-mapping"
+	// WarningCodeDefaultedValue is reported when a value was not provided and a default was used.
+	WarningCodeDefaultedValue WarningCode = "defaulted-value"
+	// WarningCodeUnresolvedReference is reported when a referenced object is not a part of the converted objects.
+	WarningCodeUnresolvedReference WarningCode = "unresolved-reference"
+)
+
+// Warning describes a single issue encountered while converting an OpenSLO object
+// which did not prevent the conversion.
+type Warning struct {
+	Kind    openslo.Kind `json:"kind"`
+	Name    string       `json:"name"`
+	Path    string       `json:"path,omitempty"`
+	Code    WarningCode  `json:"code"`
+	Message string       `json:"message"`
+}
+
+func (w Warning) String() string {
+	if w.Path == "" {
+		return fmt.Sprintf("%s %s: %s: %s", w.Kind, w.Name, w.Code, w.Message)
+	}
+	return fmt.Sprintf("%s %s: %s: %s: %s", w.Kind, w.Name, w.Path, w.Code, w.Message)
+}
+
+// ConversionReport lists all the warnings reported during conversion.
+type ConversionReport struct {
+	Warnings []Warning `json:"warnings,omitempty"`
+}
+
+// newWarning creates a new [Warning] for the OpenSLO object.
+func newWarning(object openslo.Object, path string, code WarningCode, msg string) Warning {
+	return Warning{
+		Kind:    object.GetKind(),
+		Name:    object.GetName(),
+		Path:    path,
+		Code:    code,
+		Message: msg,
+	}
+}
@@ -0,0 +1,122 @@
+package openslotonobl9
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/OpenSLO/go-sdk/pkg/openslo"
+	"github.com/OpenSLO/go-sdk/pkg/openslosdk"
+	"github.com/nobl9/nobl9-go/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nobl9/nobl9-openslo/pkg/nobl9toopenslo"
+)
+
+// TestConvert_RoundTrip ensures OpenSLO -> Nobl9 -> OpenSLO conversion yields
+// a semantically equal document for every v1 input file.
+// Nobl9 objects are always converted to OpenSLO v1, other versions are not expected to round-trip.
+// Both the input and the result have their references resolved before comparison,
+// this way inlined and referenced objects are treated as equal.
+// Inline metric sources are extracted from the input, they are converted to separate Nobl9 objects.
+// Generated Nobl9 Projects have no OpenSLO counterpart, they are not converted back.
+// Both documents are normalized with [normalizeRoundTripValue] before comparison.
+func TestConvert_RoundTrip(t *testing.T) {
+	for _, fileName := range listAllFilesInDir(t, inputsDir) {
+		if !strings.HasPrefix(fileName, "v1_") {
+			continue
+		}
+		t.Run(fileName, func(t *testing.T) {
+			inputFileData, err := os.ReadFile(filepath.Join(inputsDir, fileName))
+			require.NoError(t, err)
+			opensloObjects, err := openslosdk.Decode(bytes.NewReader(inputFileData), openslosdk.FormatYAML)
+			require.NoError(t, err)
+			// Decode the expected objects separately, reference resolution mutates the objects.
+			expected, err := openslosdk.Decode(bytes.NewReader(inputFileData), openslosdk.FormatYAML)
+			require.NoError(t, err)
+			expected, err = resolveObjectReferences(expected)
+			require.NoError(t, err)
+			converter := NewConverter(convertOptions[fileName]...)
+			projects, err := converter.resolveProjects(expected)
+			require.NoError(t, err)
+			expected, err = converter.extractInlineDataSources(expected, projects)
+			require.NoError(t, err)
+
+			nobl9Objects, err := ConvertWithOptions(opensloObjects, convertOptions[fileName]...)
+			require.NoError(t, err)
+			nobl9Objects = slices.DeleteFunc(nobl9Objects, func(object manifest.Object) bool {
+				return object.GetKind() == manifest.KindProject
+			})
+			actual, err := nobl9toopenslo.Convert(nobl9Objects)
+			require.NoError(t, err)
+
+			actual, err = resolveObjectReferences(actual)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, encodeSortedObjects(t, expected), encodeSortedObjects(t, actual))
+		})
+	}
+}
+
+func encodeSortedObjects(t *testing.T, objects []openslo.Object) string {
+	t.Helper()
+
+	objects = slices.SortedFunc(slices.Values(objects), func(o1, o2 openslo.Object) int {
+		return cmp.Or(
+			cmp.Compare(o1.GetKind().String(), o2.GetKind().String()),
+			cmp.Compare(o1.GetName(), o2.GetName()),
+		)
+	})
+	var buf bytes.Buffer
+	require.NoError(t, openslosdk.Encode(&buf, openslosdk.FormatJSON, objects...))
+	var decoded any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	normalized, err := json.Marshal(normalizeRoundTripValue("", decoded))
+	require.NoError(t, err)
+	return string(normalized)
+}
+
+// durationShorthandRegex matches OpenSLO duration shorthand which has a fixed length in minutes.
+var durationShorthandRegex = regexp.MustCompile(`^(\d+)([mhdw])$`)
+
+// normalizeRoundTripValue normalizes the values which the conversion writes in a different,
+// yet equivalent form.
+// Duration shorthands are expressed in minutes, e.g. '2w' and '14d' both become '20160m'.
+// Alert severities are lowercased, e.g. 'low' and 'Low' both become 'low'.
+func normalizeRoundTripValue(key string, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		normalized := make(map[string]any, len(v))
+		for k, value := range v {
+			normalized[k] = normalizeRoundTripValue(k, value)
+		}
+		return normalized
+	case []any:
+		normalized := make([]any, 0, len(v))
+		for _, value := range v {
+			normalized = append(normalized, normalizeRoundTripValue(key, value))
+		}
+		return normalized
+	case string:
+		if key == "severity" {
+			return strings.ToLower(v)
+		}
+		matches := durationShorthandRegex.FindStringSubmatch(v)
+		if matches == nil {
+			return v
+		}
+		count, _ := strconv.Atoi(matches[1])
+		minutes := map[string]int{"m": 1, "h": 60, "d": 24 * 60, "w": 7 * 24 * 60}[matches[2]]
+		return strconv.Itoa(count*minutes) + "m"
+	default:
+		return v
+	}
+}
@@ -0,0 +1,329 @@
+package openslotonobl9
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/nobl9/govy/pkg/govy"
+)
+
+// SourcePosition identifies a node in the source file.
+type SourcePosition struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+func (p SourcePosition) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// SourceError is an error which was located in the source file.
+type SourceError struct {
+	Position SourcePosition `json:"position"`
+	Err      error          `json:"-"`
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Position, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// sourceObject lists positions of all the nodes of a single OpenSLO object, keyed by their dot paths.
+type sourceObject struct {
+	version   string
+	kind      string
+	name      string
+	positions map[string]SourcePosition
+}
+
+// parseSourceObjects returns the nodes of all the OpenSLO objects defined in the YAML or JSON data,
+// in the same order as the objects decoded by [openslosdk.Decode].
+func parseSourceObjects(filename string, data []byte) ([]sourceObject, error) {
+	file, err := parser.ParseBytes(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	var objects []sourceObject
+	for _, doc := range file.Docs {
+		if doc.Body == nil {
+			continue
+		}
+		switch body := unwrapNode(doc.Body).(type) {
+		case *ast.SequenceNode:
+			for _, value := range body.Values {
+				objects = append(objects, newSourceObject(filename, value))
+			}
+		default:
+			objects = append(objects, newSourceObject(filename, body))
+		}
+	}
+	return objects, nil
+}
+
+func newSourceObject(filename string, node ast.Node) sourceObject {
+	object := sourceObject{positions: make(map[string]SourcePosition)}
+	collectPositions(filename, node, "", object.positions)
+	object.positions[""] = nodePosition(filename, node)
+	object.version, _ = mappingValue(node, "apiVersion")
+	object.kind, _ = mappingValue(node, "kind")
+	object.name, _ = mappingValue(node, "metadata.name")
+	return object
+}
+
+func collectPositions(filename string, node ast.Node, path string, positions map[string]SourcePosition) {
+	switch n := unwrapNode(node).(type) {
+	case *ast.MappingNode:
+		for _, value := range n.Values {
+			collectPositions(filename, value, path, positions)
+		}
+	case *ast.MappingValueNode:
+		valuePath := joinDotPath(path, n.Key.GetToken().Value)
+		positions[valuePath] = nodePosition(filename, n.Key)
+		collectPositions(filename, n.Value, valuePath, positions)
+	case *ast.SequenceNode:
+		for i, value := range n.Values {
+			valuePath := joinDotPath(path, strconv.Itoa(i))
+			positions[valuePath] = nodePosition(filename, value)
+			collectPositions(filename, value, valuePath, positions)
+		}
+	}
+}
+
+func nodePosition(filename string, node ast.Node) SourcePosition {
+	tok := node.GetToken()
+	if tok == nil || tok.Position == nil {
+		return SourcePosition{File: filename}
+	}
+	return SourcePosition{File: filename, Line: tok.Position.Line, Column: tok.Position.Column}
+}
+
+// Locate wraps every validation and conversion error which can be attributed
+// to a node of the loaded objects with [SourceError], which points to its 'file:line:col'.
+// Errors which cannot be located are returned as is.
+//
+// OpenSLO validation errors point to the index of the converted object,
+// the objects must be converted in the order they were returned by [LoadPathsWithSources].
+// If the node does not exist in the source, for instance when a required field is missing
+// or the error relates to an inlined object, the nearest existing parent node is used.
+func (s ObjectSources) Locate(err error) error {
+	errs := s.locate(err)
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+func (s ObjectSources) locate(err error) []error {
+	switch v := err.(type) {
+	case *govy.ValidatorError:
+		return s.locateValidatorError(v)
+	case govy.ValidatorErrors:
+		var errs []error
+		for _, vErr := range v {
+			errs = append(errs, s.locateValidatorError(vErr)...)
+		}
+		return errs
+	case *ObjectConversionError:
+		object, ok := s.findObject(func(o sourceObject) bool {
+			return o.kind == v.Kind.String() && o.name == v.Name
+		})
+		if !ok {
+			return []error{v}
+		}
+		return []error{&SourceError{Position: object.find(v.Path), Err: v}}
+	case ObjectConversionErrors:
+		var errs []error
+		for _, cErr := range v {
+			errs = append(errs, s.locate(cErr)...)
+		}
+		return errs
+	case interface{ Unwrap() []error }:
+		var errs []error
+		for _, wrapped := range v.Unwrap() {
+			errs = append(errs, s.locate(wrapped)...)
+		}
+		return errs
+	}
+	// OpenSLO SDK validation errors are wrapped, the wrapper is kept if none of them was located.
+	var vErrs govy.ValidatorErrors
+	if errors.As(err, &vErrs) {
+		errs := s.locate(vErrs)
+		isLocated := func(err error) bool {
+			_, ok := err.(*SourceError)
+			return ok
+		}
+		if slices.ContainsFunc(errs, isLocated) {
+			return errs
+		}
+	}
+	return []error{err}
+}
+
+// locateValidatorError splits the error into separate errors for each property.
+func (s ObjectSources) locateValidatorError(err *govy.ValidatorError) []error {
+	var (
+		object sourceObject
+		ok     bool
+	)
+	if index := err.SliceIndex; index != nil {
+		// OpenSLO SDK validation errors point to the index of the decoded object.
+		if *index >= 0 && *index < len(s.objects) {
+			object = s.objects[*index]
+			ok = object.positions != nil
+		}
+	} else {
+		object, ok = s.findObject(func(o sourceObject) bool {
+			return objectValidationName(o.version, o.kind, o.name) == err.Name
+		})
+	}
+	if !ok {
+		return []error{err}
+	}
+	errs := make([]error, 0, len(err.Errors))
+	for _, propErr := range err.Errors {
+		errs = append(errs, &SourceError{
+			Position: object.find(govyPathToDotPath(propErr.PropertyPath.String())),
+			Err: &govy.ValidatorError{
+				Errors:     govy.PropertyErrors{propErr},
+				Name:       err.Name,
+				SliceIndex: err.SliceIndex,
+			},
+		})
+	}
+	return errs
+}
+
+func (s ObjectSources) findObject(match func(o sourceObject) bool) (sourceObject, bool) {
+	for _, object := range s.objects {
+		if object.positions != nil && match(object) {
+			return object, true
+		}
+	}
+	return sourceObject{}, false
+}
+
+// find returns the position of the node at the given path or its nearest existing parent.
+func (o sourceObject) find(path string) SourcePosition {
+	for {
+		if pos, ok := o.positions[path]; ok {
+			return pos
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return o.positions[""]
+		}
+		path = path[:i]
+	}
+}
+
+func unwrapNode(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.TagNode:
+			node = n.Value
+		case *ast.AnchorNode:
+			node = n.Value
+		case *ast.DocumentNode:
+			node = n.Body
+		default:
+			return node
+		}
+	}
+}
+
+// mappingValue returns the scalar value found at the dot path, keys must not contain dots.
+func mappingValue(node ast.Node, path string) (string, bool) {
+	for _, key := range strings.Split(path, ".") {
+		var found bool
+		switch n := unwrapNode(node).(type) {
+		case *ast.MappingNode:
+			for _, value := range n.Values {
+				if value.Key.GetToken().Value == key {
+					node, found = value.Value, true
+					break
+				}
+			}
+		case *ast.MappingValueNode:
+			if n.Key.GetToken().Value == key {
+				node, found = n.Value, true
+			}
+		}
+		if !found {
+			return "", false
+		}
+	}
+	scalar, ok := unwrapNode(node).(ast.ScalarNode)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprint(scalar.GetValue()), true
+}
+
+func joinDotPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
+
+// govyPathToDotPath converts govy property path, like "spec.objectives[0]['nobl9.com/key']",
+// to the dot path used by the conversion, like "spec.objectives.0.nobl9.com/key".
+// Wildcards and unknown indexes end the path.
+func govyPathToDotPath(path string) string {
+	var (
+		elements []string
+		current  strings.Builder
+	)
+	flush := func() {
+		if current.Len() > 0 {
+			elements = append(elements, current.String())
+			current.Reset()
+		}
+	}
+	path = strings.TrimPrefix(path, "$")
+loop:
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == '.':
+			flush()
+		case strings.HasPrefix(path[i:], "['"):
+			flush()
+			for i += 2; i < len(path) && path[i] != '\''; i++ {
+				if path[i] == '\\' && i+1 < len(path) {
+					i++
+				}
+				current.WriteByte(path[i])
+			}
+			// Skip the closing bracket.
+			i++
+			flush()
+		case c == '[':
+			flush()
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				break loop
+			}
+			index := path[i+1 : i+end]
+			if _, err := strconv.Atoi(index); err != nil {
+				break loop
+			}
+			elements = append(elements, index)
+			i += end
+		case c == '*':
+			break loop
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+	return strings.Join(elements, ".")
+}
@@ -0,0 +1,103 @@
+package openslotonobl9
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nobl9/govy/pkg/govy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sourceTestData = `apiVersion: openslo/v1
+kind: Service
+metadata:
+  name: web
+spec: {}
+---
+apiVersion: openslo/v1
+kind: AlertNotificationTarget
+metadata:
+  name: web-notification
+spec:
+  target: foo
+---
+- apiVersion: openslo/v1
+  kind: SLO
+  metadata:
+    name: web-latency
+    annotations:
+      nobl9.com/spec.indicator.metricSource.kind: Agent
+      nobl9.com/spec.indicator.metricSource.project: prometheus
+  spec:
+    service: web
+    budgetingMethod: Timeslices
+    indicator:
+      metadata:
+        name: web-latency
+      spec:
+        thresholdMetric:
+          metricSource:
+            metricSourceRef: prometheus
+            type: prometheus
+            spec:
+              promql: api_server_requestMsec{job="nginx"}
+    timeWindow:
+      - duration: 1d
+        isRolling: true
+    objectives:
+      - displayName: Good
+        target: 0.995
+        op: lt
+        value: 200
+        timeSliceTarget: 0.95
+        timeSliceWindow: 1m
+`
+
+func TestObjectSources_Locate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "slos.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(sourceTestData), 0o600))
+	objects, sources, err := LoadPathsWithSources(dir)
+	require.NoError(t, err)
+
+	_, err = NewConverter(WithStrictMode()).Convert(objects)
+	require.Error(t, err)
+	err = sources.Locate(err)
+
+	var sourceErrs []*SourceError
+	for _, wrapped := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var sourceErr *SourceError
+		require.ErrorAs(t, wrapped, &sourceErr)
+		sourceErrs = append(sourceErrs, sourceErr)
+	}
+	require.Len(t, sourceErrs, 2)
+
+	assert.Equal(t, SourcePosition{File: path, Line: 12, Column: 3}, sourceErrs[0].Position)
+	var validatorErr *govy.ValidatorError
+	require.ErrorAs(t, sourceErrs[0], &validatorErr)
+	require.Len(t, validatorErr.Errors, 1)
+	assert.Equal(t, "spec.target", validatorErr.Errors[0].PropertyPath.String())
+
+	assert.Equal(t, SourcePosition{File: path, Line: 43, Column: 9}, sourceErrs[1].Position)
+	assert.ErrorContains(t, sourceErrs[1], path+":43:9: failed to convert SLO web-latency"+
+		" at spec.objectives.0.timeSliceWindow: strict mode does not allow warnings")
+}
+
+func TestGovyPathToDotPath(t *testing.T) {
+	tests := map[string]string{
+		"":                            "",
+		"spec.target":                 "spec.target",
+		"$.spec.objectives[0].target": "spec.objectives.0.target",
+		"metadata.annotations['nobl9.com/metadata.project']": "metadata.annotations.nobl9.com/metadata.project",
+		`metadata.annotations['it\'s']`:                      "metadata.annotations.it's",
+		"spec.objectives[*].target":                          "spec.objectives",
+		"spec.objectives[].target":                           "spec.objectives",
+	}
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, expected, govyPathToDotPath(path))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package openslotonobl9
 
 import (
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/OpenSLO/go-sdk/pkg/openslo"
 	v1 "github.com/OpenSLO/go-sdk/pkg/openslo/v1"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v1alpha"
+	"github.com/OpenSLO/go-sdk/pkg/openslo/v2alpha"
 	"github.com/nobl9/govy/pkg/govy"
 	"github.com/nobl9/govy/pkg/jsonpath"
 	"github.com/nobl9/govy/pkg/rules"
@@ -22,18 +25,56 @@ import (
 var opensloObjectValidation = govy.New(
 	govy.For(func(o openslo.Object) openslo.Version { return o.GetVersion() }).
 		WithName("apiVersion").
-		Rules(rules.OneOf(openslo.VersionV1)),
+		Rules(rules.OneOf(openslo.VersionV1, openslo.VersionV1alpha, openslo.VersionV2alpha)),
 	govy.For(govy.GetSelf[openslo.Object]()).
 		When(func(o openslo.Object) bool { return o.GetVersion() == openslo.VersionV1 }).
 		Include(
 			opensloV1AnnotationsValidation,
 			opensloV1Validation,
 		),
+	govy.For(govy.GetSelf[openslo.Object]()).
+		When(func(o openslo.Object) bool { return o.GetVersion() == openslo.VersionV1alpha }).
+		Include(opensloV1alphaValidation),
+	govy.For(govy.GetSelf[openslo.Object]()).
+		When(func(o openslo.Object) bool { return o.GetVersion() == openslo.VersionV2alpha }).
+		Include(
+			opensloV2alphaAnnotationsValidation,
+			opensloV2alphaValidation,
+		),
 ).
 	WithNameFunc(func(o openslo.Object) string {
-		return fmt.Sprintf("%s.%s %s", o.GetVersion(), o.GetKind(), o.GetName())
+		return objectValidationName(o.GetVersion().String(), o.GetKind().String(), o.GetName())
 	})
 
+// objectValidationName is the name of [opensloObjectValidation] errors,
+// it is used to find the faulty object in the source.
+func objectValidationName(version, kind, name string) string {
+	return fmt.Sprintf("%s.%s %s", version, kind, name)
+}
+
+// validateOpenSLOObject validates the object with [opensloObjectValidation],
+// its metric specs with [validateMetricSpecs] and its connection details with [validateConnectionDetails].
+// Errors of all are reported as a single [govy.ValidatorError].
+func validateOpenSLOObject(object openslo.Object) error {
+	err := opensloObjectValidation.Validate(object)
+	var propertyErrs govy.PropertyErrors
+	propertyErrs = append(propertyErrs, validateMetricSpecs(object)...)
+	propertyErrs = append(propertyErrs, validateConnectionDetails(object)...)
+	if len(propertyErrs) == 0 {
+		return err
+	}
+	var validatorErr *govy.ValidatorError
+	switch {
+	case err == nil:
+		validatorErr = govy.NewValidatorError(nil).WithName(
+			objectValidationName(object.GetVersion().String(), object.GetKind().String(), object.GetName()))
+	case !errors.As(err, &validatorErr):
+		return err
+	}
+	validatorErr.Errors = append(validatorErr.Errors, propertyErrs...)
+	return validatorErr
+}
+
 var opensloV1Validation = govy.New(
 	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v1.Service]).
 		When(whenObjectIsKind(openslo.KindService)),
@@ -51,9 +92,33 @@ var opensloV1Validation = govy.New(
 				Rules(rules.OneOf(slices.Sorted(maps.Keys(getAlertMethodTypes()))...)),
 		)),
 	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v1.AlertPolicy]).
-		When(whenObjectIsKind(openslo.KindAlertPolicy)),
+		When(whenObjectIsKind(openslo.KindAlertPolicy)).
+		Include(opensloV1AlertPolicyValidation),
+)
+
+var opensloV1AlertPolicyValidation = govy.New(
+	govy.ForSlice(func(a v1.AlertPolicy) []v1.AlertPolicyCondition { return a.Spec.Conditions }).
+		WithPath(jsonpath.Parse("spec.conditions")).
+		IncludeForEach(govy.New(
+			govy.ForPointer(func(c v1.AlertPolicyCondition) *v1.AlertPolicyConditionInline {
+				return c.AlertPolicyConditionInline
+			}).
+				Include(govy.New(
+					govy.For(func(c v1.AlertPolicyConditionInline) string { return c.Spec.Severity }).
+						WithPath(jsonpath.Parse("spec.severity")).
+						Rules(opensloSeverityRule),
+				)),
+		)),
 )
 
+// opensloSeverityRule matches the severity with Nobl9 severities, ignoring case.
+var opensloSeverityRule = govy.NewRule(func(severity string) error {
+	if _, ok := parseSeverity(severity); !ok {
+		return govy.NewRuleError("must be one of (case-insensitive): Low, Medium, High", rules.ErrorCodeOneOf)
+	}
+	return nil
+})
+
 var opensloV1AnnotationsValidation = govy.New(
 	govy.Transform(
 		govy.GetSelf[openslo.Object](),
@@ -107,7 +172,9 @@ var opensloV1SLIMetricSpecValidation = govy.New(
 			govy.For(func(s v1.SLIMetricSource) string { return s.MetricSourceRef }).
 				WithName("metricSourceRef").
 				Required().
-				Rules(rules.StringNotEmpty()),
+				Rules(rules.StringNotEmpty().
+					WithDetails("Nobl9 requires metrics to reference a named DataSource"+
+						" or to define an inline one in 'spec.connectionDetails'")),
 			govy.For(func(s v1.SLIMetricSource) string { return s.Type }).
 				WithName("type").
 				Rules(rules.OneOf(getMetricSpecTypeNames()...)),
@@ -137,6 +204,231 @@ var opensloV1DataSourceValidation = govy.New(
 		Rules(rules.OneOf(getDataSourceTypeNames(manifest.KindAgent)...)),
 )
 
+var opensloV1alphaValidation = govy.New(
+	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v1alpha.SLO]).
+		When(whenObjectIsKind(openslo.KindSLO)).
+		Include(opensloV1alphaSLOValidation),
+)
+
+var opensloV1alphaSLOValidation = govy.New(
+	govy.For(func(s v1alpha.SLO) v1alpha.SLOSpec { return s.Spec }).
+		WithName("spec").
+		Rules(govy.NewRule(func(s v1alpha.SLOSpec) error {
+			sources := make(map[string]struct{})
+			if s.Indicator != nil {
+				sources[s.Indicator.ThresholdMetric.Source] = struct{}{}
+			}
+			for _, objective := range s.Objectives {
+				if objective.RatioMetrics == nil {
+					continue
+				}
+				sources[objective.RatioMetrics.Good.Source] = struct{}{}
+				sources[objective.RatioMetrics.Total.Source] = struct{}{}
+			}
+			if len(sources) > 1 {
+				return govy.NewRuleError(
+					"all metrics must define the same 'source', Nobl9 SLO supports only a single metric source")
+			}
+			return nil
+		})).
+		Include(govy.New(
+			govy.ForPointer(func(s v1alpha.SLOSpec) *v1alpha.SLOIndicator { return s.Indicator }).
+				WithName("indicator").
+				Include(govy.New(
+					govy.For(func(i v1alpha.SLOIndicator) v1alpha.SLOMetricSourceSpec { return i.ThresholdMetric }).
+						WithName("thresholdMetric").
+						Include(opensloV1alphaMetricSourceValidation),
+				)),
+			govy.ForSlice(func(s v1alpha.SLOSpec) []v1alpha.SLOObjective { return s.Objectives }).
+				WithName("objectives").
+				IncludeForEach(govy.New(
+					govy.ForPointer(func(o v1alpha.SLOObjective) *v1alpha.SLORatioMetrics { return o.RatioMetrics }).
+						WithName("ratioMetrics").
+						Include(govy.New(
+							govy.For(func(r v1alpha.SLORatioMetrics) v1alpha.SLOMetricSourceSpec { return r.Good }).
+								WithName("good").
+								Include(opensloV1alphaMetricSourceValidation),
+							govy.For(func(r v1alpha.SLORatioMetrics) v1alpha.SLOMetricSourceSpec { return r.Total }).
+								WithName("total").
+								Include(opensloV1alphaMetricSourceValidation),
+						)),
+				)),
+			govy.ForSlice(func(s v1alpha.SLOSpec) []v1alpha.SLOTimeWindow { return s.TimeWindows }).
+				WithName("timeWindows").
+				IncludeForEach(govy.New(
+					govy.For(func(t v1alpha.SLOTimeWindow) int { return t.Count }).
+						WithName("count").
+						When(func(t v1alpha.SLOTimeWindow) bool { return t.Unit == v1alpha.SLOTimeWindowUnitSecond }).
+						Rules(govy.NewRule(func(c int) error {
+							if c%60 != 0 {
+								return govy.NewRuleError(
+									"Nobl9 does not support second precision, time window must be a whole number of minutes")
+							}
+							return nil
+						})),
+				)),
+		)),
+)
+
+var opensloV1alphaMetricSourceValidation = govy.New(
+	append(
+		[]govy.PropertyRulesInterface[v1alpha.SLOMetricSourceSpec]{
+			govy.For(func(s v1alpha.SLOMetricSourceSpec) string { return s.Source }).
+				WithName("source").
+				Rules(rules.OneOf(getMetricSpecTypeNames()...)),
+		},
+		getV1alphaQueryTypeRules()...,
+	)...,
+)
+
+var opensloV2alphaValidation = govy.New(
+	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v2alpha.SLO]).
+		When(whenObjectIsKind(openslo.KindSLO)).
+		Include(opensloV2alphaSLOValidation),
+	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v2alpha.DataSource]).
+		When(whenObjectIsKind(openslo.KindDataSource)).
+		Include(opensloV2alphaDataSourceValidation),
+	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v2alpha.AlertNotificationTarget]).
+		When(whenObjectIsKind(openslo.KindAlertNotificationTarget)).
+		Include(govy.New(
+			govy.For(func(a v2alpha.AlertNotificationTarget) string { return a.Spec.Target }).
+				WithPath(jsonpath.Parse("spec.target")).
+				Rules(rules.OneOf(slices.Sorted(maps.Keys(getAlertMethodTypes()))...)),
+		)),
+	govy.Transform(govy.GetSelf[openslo.Object](), objectTransformer[v2alpha.AlertPolicy]).
+		When(whenObjectIsKind(openslo.KindAlertPolicy)).
+		Include(opensloV2alphaAlertPolicyValidation),
+)
+
+var opensloV2alphaAlertPolicyValidation = govy.New(
+	govy.ForSlice(func(a v2alpha.AlertPolicy) []v2alpha.AlertPolicyCondition { return a.Spec.Conditions }).
+		WithPath(jsonpath.Parse("spec.conditions")).
+		IncludeForEach(govy.New(
+			govy.ForPointer(func(c v2alpha.AlertPolicyCondition) *v2alpha.AlertPolicyConditionInline {
+				return c.AlertPolicyConditionInline
+			}).
+				Include(govy.New(
+					govy.For(func(c v2alpha.AlertPolicyConditionInline) string { return c.Spec.Severity }).
+						WithPath(jsonpath.Parse("spec.severity")).
+						Rules(opensloSeverityRule),
+				)),
+		)),
+)
+
+var opensloV2alphaAnnotationsValidation = govy.New(
+	govy.Transform(
+		govy.GetSelf[openslo.Object](),
+		func(o openslo.Object) (v2alpha.Object, error) { return o.(v2alpha.Object), nil },
+	).
+		Include(govy.New(
+			govy.For(func(o v2alpha.Object) v2alpha.Metadata { return o.GetMetadata() }).
+				WithName("metadata").
+				Include(govy.New(
+					govy.ForMap(func(m v2alpha.Metadata) v2alpha.Annotations { return m.Annotations }).
+						WithName("annotations").
+						RulesForKeys(rules.NotOneOf(DomainNobl9+"/kind", DomainNobl9+"/apiVersion")),
+				)),
+		)),
+).
+	When(func(o openslo.Object) bool { return o.GetKind() != openslo.KindDataSource })
+
+var opensloV2alphaSLOValidation = govy.New(
+	govy.For(func(s v2alpha.SLO) v2alpha.SLOBudgetingMethod { return s.Spec.BudgetingMethod }).
+		WithPath(jsonpath.Parse("spec.budgetingMethod")).
+		Rules(rules.OneOf(v2alpha.SLOBudgetingMethodOccurrences, v2alpha.SLOBudgetingMethodTimeslices)),
+	govy.ForPointer(func(s v2alpha.SLO) *v2alpha.SLOSLIInline { return s.Spec.SLI }).
+		WithPath(jsonpath.Parse("spec.sli")).
+		Include(opensloV2alphaSLIInlineValidation),
+	govy.ForSlice(func(s v2alpha.SLO) []v2alpha.SLOObjective { return s.Spec.Objectives }).
+		WithPath(jsonpath.Parse("spec.objectives")).
+		IncludeForEach(govy.New(
+			govy.ForPointer(func(o v2alpha.SLOObjective) *v2alpha.SLOSLIInline { return o.SLI }).
+				WithName("sli").
+				Include(opensloV2alphaSLIInlineValidation),
+		)),
+)
+
+var opensloV2alphaSLIInlineValidation = govy.New(
+	govy.For(func(s v2alpha.SLOSLIInline) v2alpha.SLISpec { return s.Spec }).
+		WithName("spec").
+		Include(govy.New(
+			govy.ForPointer(func(s v2alpha.SLISpec) *v2alpha.SLIMetricSpec { return s.ThresholdMetric }).
+				WithName("thresholdMetric").
+				Include(opensloV2alphaSLIMetricSpecValidation),
+			govy.ForPointer(func(s v2alpha.SLISpec) *v2alpha.SLIRatioMetric { return s.RatioMetric }).
+				WithName("ratioMetric").
+				Include(govy.New(
+					govy.ForPointer(func(r v2alpha.SLIRatioMetric) *v2alpha.SLIMetricSpec { return r.Total }).
+						WithName("total").
+						Include(opensloV2alphaSLIMetricSpecValidation),
+					govy.ForPointer(func(r v2alpha.SLIRatioMetric) *v2alpha.SLIMetricSpec { return r.Good }).
+						WithName("good").
+						Include(opensloV2alphaSLIMetricSpecValidation),
+					govy.ForPointer(func(r v2alpha.SLIRatioMetric) *v2alpha.SLIMetricSpec { return r.Bad }).
+						WithName("bad").
+						Include(opensloV2alphaSLIMetricSpecValidation),
+					govy.ForPointer(func(r v2alpha.SLIRatioMetric) *v2alpha.SLIMetricSpec { return r.Raw }).
+						WithName("raw").
+						Rules(rules.Forbidden[v2alpha.SLIMetricSpec]().
+							WithDetails("Nobl9 does not support raw ratio metrics")),
+				)),
+		)),
+)
+
+var opensloV2alphaSLIMetricSpecValidation = govy.New(
+	govy.For(func(s v2alpha.SLIMetricSpec) string { return s.DataSourceRef }).
+		WithName("dataSourceRef").
+		Required().
+		Rules(rules.StringNotEmpty().
+			WithDetails("Nobl9 requires metrics to reference a named DataSource")),
+	govy.ForPointer(func(s v2alpha.SLIMetricSpec) *v2alpha.DataSourceSpec { return s.DataSourceSpec }).
+		WithName("dataSourceSpec").
+		Include(govy.New(
+			govy.For(func(d v2alpha.DataSourceSpec) string { return d.Type }).
+				WithName("type").
+				Rules(rules.OneOf(getMetricSpecTypeNames()...)),
+		)),
+)
+
+var opensloV2alphaDataSourceValidation = govy.New(
+	govy.ForMap(func(d v2alpha.DataSource) v2alpha.Annotations { return d.Metadata.Annotations }).
+		WithPath(jsonpath.Parse("metadata.annotations")).
+		RulesForKeys(rules.NEQ(DomainNobl9+"/apiVersion")).
+		IncludeForItems(govy.New(
+			govy.For(func(m govy.MapItem[string, string]) string { return m.Value }).
+				When(func(m govy.MapItem[string, string]) bool { return m.Key == DomainNobl9+"/kind" }).
+				Rules(rules.OneOf(manifest.KindAgent.String(), manifest.KindDirect.String())),
+		)),
+	govy.For(func(d v2alpha.DataSource) string { return d.Spec.Type }).
+		When(func(d v2alpha.DataSource) bool {
+			return d.Metadata.Annotations[DomainNobl9+"/kind"] == manifest.KindDirect.String()
+		}).
+		WithPath(jsonpath.Parse("spec.type")).
+		Rules(rules.OneOf(getDataSourceTypeNames(manifest.KindDirect)...)),
+	govy.For(func(d v2alpha.DataSource) string { return d.Spec.Type }).
+		When(func(d v2alpha.DataSource) bool {
+			return d.Metadata.Annotations[DomainNobl9+"/kind"] != manifest.KindDirect.String()
+		}).
+		WithPath(jsonpath.Parse("spec.type")).
+		Rules(rules.OneOf(getDataSourceTypeNames(manifest.KindAgent)...)),
+)
+
+// getV1alphaQueryTypeRules returns rules which ensure that the query type
+// matches one of the fields of Nobl9 metric spec defined by the source.
+func getV1alphaQueryTypeRules() []govy.PropertyRulesInterface[v1alpha.SLOMetricSourceSpec] {
+	queryTypes := getMetricSpecQueryTypeNames()
+	propertyRules := make([]govy.PropertyRulesInterface[v1alpha.SLOMetricSourceSpec], 0, len(queryTypes))
+	for _, source := range slices.Sorted(maps.Keys(queryTypes)) {
+		propertyRules = append(propertyRules,
+			govy.For(func(s v1alpha.SLOMetricSourceSpec) string { return s.QueryType }).
+				WithName("queryType").
+				When(func(s v1alpha.SLOMetricSourceSpec) bool { return s.Source == source }).
+				Rules(rules.OneOf(queryTypes[source]...)),
+		)
+	}
+	return propertyRules
+}
+
 func getMetricSpecTypeNames() []string {
 	rt := reflect.TypeOf(slo.MetricSpec{})
 	names := make([]string, 0, rt.NumField())
@@ -152,6 +444,32 @@ func getMetricSpecTypeNames() []string {
 	return names
 }
 
+// getMetricSpecQueryTypeNames returns a map of metric spec types and their field names.
+func getMetricSpecQueryTypeNames() map[string][]string {
+	rt := reflect.TypeOf(slo.MetricSpec{})
+	queryTypes := make(map[string][]string, rt.NumField())
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		typ := field.Type
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			continue
+		}
+		names := make([]string, 0, typ.NumField())
+		for j := range typ.NumField() {
+			tag := typ.Field(j).Tag.Get("json")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			names = append(names, strings.Split(tag, ",")[0])
+		}
+		queryTypes[strings.Split(field.Tag.Get("json"), ",")[0]] = names
+	}
+	return queryTypes
+}
+
 func getAlertMethodTypes() map[string]any {
 	rt := reflect.TypeOf(alertmethod.Spec{})
 	types := make(map[string]any, rt.NumField())